@@ -3,12 +3,12 @@ package components
 import (
 	"github.com/spf13/cobra"
 
-	"k8s.io/cli-runtime/pkg/genericclioptions"
-
+	"github.com/lburgazzoli/odh-cli/cmd/components/describe"
 	"github.com/lburgazzoli/odh-cli/cmd/components/disable"
 	"github.com/lburgazzoli/odh-cli/cmd/components/enable"
 	"github.com/lburgazzoli/odh-cli/cmd/components/get"
 	"github.com/lburgazzoli/odh-cli/cmd/components/list"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 const (
@@ -20,7 +20,7 @@ Components are cluster-scoped resources that define ODH/RHOAI platform component
 )
 
 // AddCommand adds the components subcommand to the root command.
-func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
+func AddCommand(root *cobra.Command, flags *utilclient.Flags) {
 	cmd := &cobra.Command{
 		Use:          cmdName,
 		Short:        cmdShort,
@@ -31,6 +31,7 @@ func AddCommand(root *cobra.Command, flags *genericclioptions.ConfigFlags) {
 	// Add subcommands
 	list.AddCommand(cmd, flags)
 	get.AddCommand(cmd, flags)
+	describe.AddCommand(cmd, flags)
 	enable.AddCommand(cmd, flags)
 	disable.AddCommand(cmd, flags)
 
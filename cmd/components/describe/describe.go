@@ -0,0 +1,69 @@
+package describe
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/components/describe"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "describe"
+	cmdShort = "Show details of a specific component"
+	cmdLong  = `Show a human readable report of an ODH/RHOAI component.
+
+The report includes the status conditions and releases of the component, the
+Deployments it owns together with the readiness of their pods, and the Events
+recorded for the component and its owned resources.
+
+Deployments are looked up in the namespace set with --namespace, or in the
+applications namespace declared by the DSCInitialization when it is not set.
+Use --all-namespaces to look them up in every namespace.
+
+The component type is matched the same way as in "components get".
+
+Examples:
+  kubectl odh components describe kserve
+  kubectl odh components describe dashboard`
+)
+
+// AddCommand adds the describe subcommand to the components command.
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewDescribeOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName + " <component-type>",
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+
+	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", false, "Look up owned resources in all namespaces")
+
+	o.Redact.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -8,6 +8,8 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/components/disable"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
 )
 
 const (
@@ -15,11 +17,22 @@ const (
 	cmdShort = "Disable a component"
 	cmdLong  = `Disable an ODH/RHOAI component.
 
-This command is not yet implemented.`
+The component type is matched the same way as in "components get" and the
+singleton DataScienceCluster is updated via a merge patch that only sets
+spec.components.<name>.managementState to Removed.
+
+Before disabling, the command looks for user workloads that depend on the
+component (e.g. Notebooks for workbenches, InferenceServices for kserve) and
+refuses to proceed if any are found, unless --force is set.
+
+Examples:
+  kubectl odh components disable ray
+  kubectl odh components disable kserve --force
+  kubectl odh components disable workbenches --dry-run=server`
 )
 
 // AddCommand adds the disable subcommand to the components command.
-func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
 	o := pkgcmd.NewDisableOptions(
 		genericclioptions.IOStreams{
 			In:     os.Stdin,
@@ -46,6 +59,10 @@ func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
 		},
 	}
 
+	cmd.Flags().BoolVar(&o.Force, "force", false, "Disable the component even if resources still depend on it")
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+	cmd.Flags().Var(&o.DryRun, "dry-run", "Print the change without persisting it: the patch with client, the DataScienceCluster returned by the server with server (none|client|server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(dryrun.Client)
+
 	parent.AddCommand(cmd)
 }
-
@@ -8,6 +8,8 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/components/enable"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
 )
 
 const (
@@ -15,11 +17,18 @@ const (
 	cmdShort = "Enable a component"
 	cmdLong  = `Enable an ODH/RHOAI component.
 
-This command is not yet implemented.`
+The component type is matched the same way as in "components get" and the
+singleton DataScienceCluster is updated via a merge patch that only sets
+spec.components.<name>.managementState to Managed.
+
+Examples:
+  kubectl odh components enable kserve
+  kubectl odh components enable dashboard --wait --timeout 10m
+  kubectl odh components enable trustyai --dry-run=client`
 )
 
 // AddCommand adds the enable subcommand to the components command.
-func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
 	o := pkgcmd.NewEnableOptions(
 		genericclioptions.IOStreams{
 			In:     os.Stdin,
@@ -46,6 +55,11 @@ func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
 		},
 	}
 
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "Wait for the component to report Ready=True")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the component to become ready")
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+	cmd.Flags().Var(&o.DryRun, "dry-run", "Print the change without persisting it: the patch with client, the DataScienceCluster returned by the server with server (none|client|server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(dryrun.Client)
+
 	parent.AddCommand(cmd)
 }
-
@@ -8,6 +8,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/components/get"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 const (
@@ -24,11 +25,12 @@ type typically has one instance (e.g., "default-kserve", "default-dashboard").
 Examples:
   kubectl odh components get kserve
   kubectl odh components get dashboard
-  kubectl odh components get DataSciencePipelines`
+  kubectl odh components get DataSciencePipelines
+  kubectl odh components get kserve -o jsonpath='{.status.conditions[?(@.type=="Ready")].status}'`
 )
 
 // AddCommand adds the get subcommand to the components command.
-func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
 	o := pkgcmd.NewGetOptions(
 		genericclioptions.IOStreams{
 			In:     os.Stdin,
@@ -55,7 +57,10 @@ func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
 		},
 	}
 
-	cmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "json", "Output format (json|yaml)")
+	o.Output.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+
+	o.Redact.AddFlags(cmd.Flags())
 
 	parent.AddCommand(cmd)
 }
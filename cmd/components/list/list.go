@@ -8,6 +8,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/components/list"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 const (
@@ -16,11 +17,35 @@ const (
 	cmdShort = "List all components"
 	cmdLong  = `List all ODH/RHOAI components from the components.platform.opendatahub.io API group.
 
-Components are cluster-scoped resources.`
+Components are cluster-scoped resources.
+
+Component resources that cannot be listed (e.g. because of RBAC) are reported
+as warnings on stderr, and in the "errors" field in JSON/YAML mode. Use
+--strict to exit with a non-zero status when that happens.
+
+With --watch the command keeps running after the initial listing and prints
+updates whenever a component is added, removed, or its Ready condition or
+message changes. --watch-only skips the initial listing. In table formats each
+update is printed as a new row, aligned with the initial listing like kubectl
+get --watch; --sort-by only orders the initial listing. Other formats print each
+update as a {"type": ..., "object": ...} event like kubectl get --watch
+--output-watch-events, where type is ADDED, MODIFIED or DELETED and the current
+components are printed as ADDED.
+
+Examples:
+  kubectl odh components list
+  kubectl odh components list --watch
+  kubectl odh components list -o wide
+  kubectl odh components list -o markdown
+  kubectl odh components list --sort-by READY:desc,TYPE --no-headers
+  kubectl odh components list --filter '.status.conditions[]? | select(.type=="Ready") | .status != "True"'
+  kubectl odh components list --watch-only -o json
+  kubectl odh components list -o jsonpath='{.items[*].kind}'
+  kubectl odh components list -o go-template='{{range .items}}{{.kind}}{{"\n"}}{{end}}'`
 )
 
 // AddCommand adds the list subcommand to the components command.
-func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
 	o := pkgcmd.NewListOptions(
 		genericclioptions.IOStreams{
 			In:     os.Stdin,
@@ -47,7 +72,17 @@ func AddCommand(parent *cobra.Command, flags *genericclioptions.ConfigFlags) {
 		},
 	}
 
-	cmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "table", "Output format (table|json|yaml)")
+	o.Output.AddFlags(cmd.Flags())
+	cmd.Flags().IntVar(&o.Concurrency, "concurrency", o.Concurrency, "Maximum number of component types listed in parallel")
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+	cmd.Flags().BoolVar(&o.Strict, "strict", false, "Fail if any component resource cannot be listed")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "After listing the components, watch for changes")
+	cmd.Flags().BoolVar(&o.WatchOnly, "watch-only", false, "Watch for changes without listing the current components first")
+	cmd.Flags().StringVar(&o.SortBy, "sort-by", "", "Sort rows by comma separated column names or jq expressions, each optionally followed by :asc or :desc (e.g. READY:desc,.metadata.name)")
+	cmd.Flags().StringVar(&o.Filter, "filter", "", "Only show rows for which the jq expression is neither false nor null (e.g. '.status.phase != \"Ready\"')")
+	cmd.Flags().BoolVar(&o.NoHeaders, "no-headers", false, "Do not print the column headers")
+
+	o.Redact.AddFlags(cmd.Flags())
 
 	parent.AddCommand(cmd)
 }
@@ -0,0 +1,61 @@
+package doctor
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/doctor"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "doctor"
+	cmdShort = "Diagnose the ODH/RHOAI installation"
+	cmdLong  = `Run diagnostic checks against the ODH/RHOAI installation and report their outcome.
+
+Checks are grouped by category and each one reports OK, WARNING or ERROR.
+The command exits with a non-zero status when at least one check reports ERROR.
+
+Examples:
+  kubectl odh doctor
+  kubectl odh doctor --category Operator
+  kubectl odh doctor -o json`
+)
+
+// AddCommand adds the doctor subcommand to the root command.
+func AddCommand(root *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewDoctorOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	o.Output.AddFlags(cmd.Flags())
+	cmd.Flags().DurationVar(&o.StaleThreshold, "stale-threshold", o.StaleThreshold, "Report component conditions that are not True and have not transitioned for longer than this")
+	cmd.Flags().StringSliceVar(&o.Categories, "category", nil, "Only run the checks of the given categories (can be repeated)")
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,36 @@
+package dsc
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/lburgazzoli/odh-cli/cmd/dsc/editcomponents"
+	"github.com/lburgazzoli/odh-cli/cmd/dsc/get"
+	"github.com/lburgazzoli/odh-cli/cmd/dsc/status"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "dsc"
+	cmdShort = "Manage the DataScienceCluster"
+	cmdLong  = `Manage the DataScienceCluster from the datasciencecluster.opendatahub.io API group.
+
+The DataScienceCluster is a cluster-scoped singleton that declares which
+ODH/RHOAI components the operator should manage.`
+)
+
+// AddCommand adds the dsc subcommand to the root command.
+func AddCommand(root *cobra.Command, flags *utilclient.Flags) {
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		SilenceUsage: true,
+	}
+
+	// Add subcommands
+	get.AddCommand(cmd, flags)
+	status.AddCommand(cmd, flags)
+	editcomponents.AddCommand(cmd, flags)
+
+	root.AddCommand(cmd)
+}
@@ -0,0 +1,70 @@
+package editcomponents
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/dsc/editcomponents"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+)
+
+const (
+	cmdName  = "edit-components"
+	cmdShort = "Set the management state of one or more components"
+	cmdLong  = `Set the management state of one or more components in the DataScienceCluster.
+
+Each argument has the form <component-type>=<state>, where the component type is
+matched the same way as in "components get" and the state is one of Managed,
+Removed or Unmanaged. All changes are sent in a single merge patch request, which
+leaves the management state of the other components untouched.
+
+Before removing a component, the command looks for user workloads that depend
+on it (e.g. Notebooks for workbenches, InferenceServices for kserve) and refuses
+to proceed if any are found, unless --force is set.
+
+Examples:
+  kubectl odh dsc edit-components kserve=Managed
+  kubectl odh dsc edit-components ray=Removed codeflare=Removed
+  kubectl odh dsc edit-components kserve=Removed --force
+  kubectl odh dsc edit-components dashboard=Managed --dry-run=client`
+)
+
+// AddCommand adds the edit-components subcommand to the dsc command.
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewEditComponentsOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName + " <component-type>=<state>...",
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.Force, "force", false, "Remove components even if resources still depend on them")
+	cmd.Flags().Var(&o.DryRun, "dry-run", "Print the change without persisting it: the patch with client, the DataScienceCluster returned by the server with server (none|client|server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(dryrun.Client)
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+
+	parent.AddCommand(cmd)
+}
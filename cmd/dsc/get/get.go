@@ -0,0 +1,57 @@
+package get
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/dsc/get"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "get"
+	cmdShort = "Get the DataScienceCluster"
+	cmdLong  = `Get the singleton DataScienceCluster.
+
+Examples:
+  kubectl odh dsc get
+  kubectl odh dsc get -o yaml`
+)
+
+// AddCommand adds the get subcommand to the dsc command.
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewGetOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	o.Output.AddFlags(cmd.Flags())
+
+	o.Redact.AddFlags(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,60 @@
+package status
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/dsc/status"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "status"
+	cmdShort = "Compare desired and observed component state"
+	cmdLong  = `Show each component's desired managementState from the DataScienceCluster
+side by side with the Ready condition observed on the matching
+components.platform.opendatahub.io resource, flagging any drift between them.
+
+Examples:
+  kubectl odh dsc status
+  kubectl odh dsc status -o json`
+)
+
+// AddCommand adds the status subcommand to the dsc command.
+func AddCommand(parent *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewStatusOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	o.Output.AddFlags(cmd.Flags())
+	cmd.Flags().StringVar(&o.APIVersion, "api-version", "", "Version of the components API group to use (defaults to the server preferred version)")
+
+	o.Redact.AddEnabledFlag(cmd.Flags())
+
+	parent.AddCommand(cmd)
+}
@@ -0,0 +1,62 @@
+package dsci
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/dsci"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "dsci"
+	cmdShort = "Show the DSCInitialization"
+	cmdLong  = `Show the platform settings declared by the singleton DSCInitialization:
+the applications namespace, monitoring, service mesh and trusted CA bundle
+configuration, together with its status conditions.
+
+The applications namespace reported here is the default namespace of
+namespace-scoped odh commands, unless --namespace is set.
+
+Examples:
+  kubectl odh dsci
+  kubectl odh dsci -o yaml`
+)
+
+// AddCommand adds the dsci subcommand to the root command.
+func AddCommand(root *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewDSCIOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	o.Output.AddFlags(cmd.Flags())
+
+	o.Redact.AddFlags(cmd.Flags())
+
+	root.AddCommand(cmd)
+}
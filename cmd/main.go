@@ -5,22 +5,31 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"k8s.io/cli-runtime/pkg/genericclioptions"
-
 	"github.com/lburgazzoli/odh-cli/cmd/components"
+	"github.com/lburgazzoli/odh-cli/cmd/doctor"
+	"github.com/lburgazzoli/odh-cli/cmd/dsc"
+	"github.com/lburgazzoli/odh-cli/cmd/dsci"
+	"github.com/lburgazzoli/odh-cli/cmd/mustgather"
 	"github.com/lburgazzoli/odh-cli/cmd/version"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 func main() {
-	flags := genericclioptions.NewConfigFlags(true)
+	flags := utilclient.NewFlags()
 
 	cmd := &cobra.Command{
 		Use:   "kubectl-odh",
 		Short: "kubectl plugin for ODH diagnostic and inspection",
 	}
 
+	flags.AddFlags(cmd.PersistentFlags())
+
 	version.AddCommand(cmd, flags)
 	components.AddCommand(cmd, flags)
+	dsc.AddCommand(cmd, flags)
+	dsci.AddCommand(cmd, flags)
+	doctor.AddCommand(cmd, flags)
+	mustgather.AddCommand(cmd, flags)
 
 	if err := cmd.Execute(); err != nil {
 		os.Exit(1)
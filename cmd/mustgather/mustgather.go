@@ -0,0 +1,75 @@
+package mustgather
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	pkgcmd "github.com/lburgazzoli/odh-cli/pkg/cmd/mustgather"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	cmdName  = "must-gather"
+	cmdShort = "Collect ODH/RHOAI resources and logs for troubleshooting"
+	cmdLong  = `Collect the ODH/RHOAI resources and operator logs needed to troubleshoot an
+installation, using the must-gather layout so that the result can be inspected
+with --from-dir or --from-archive.
+
+The following are collected:
+  - every components.platform.opendatahub.io object
+  - the DataScienceCluster and DSCInitialization
+  - the operator Subscription, ClusterServiceVersion, Deployment, pods and logs
+  - the Deployments, Events and Secrets of the applications namespace
+
+Secret values, bearer tokens and credentials embedded in URLs are always
+redacted, additional fields can be masked with --redact-path. A manifest.yaml
+file summarizes what was captured and what could not be collected.
+
+The destination must not exist, unless --overwrite is set. When the collection
+fails, the partial output is removed, or left in place and reported if the
+destination existed before.
+
+Examples:
+  kubectl odh must-gather
+  kubectl odh must-gather --dest-dir /tmp/odh
+  kubectl odh must-gather --archive`
+)
+
+// AddCommand adds the must-gather subcommand to the root command.
+func AddCommand(root *cobra.Command, flags *utilclient.Flags) {
+	o := pkgcmd.NewMustGatherOptions(
+		genericclioptions.IOStreams{
+			In:     os.Stdin,
+			Out:    os.Stdout,
+			ErrOut: os.Stderr,
+		},
+		flags,
+	)
+
+	cmd := &cobra.Command{
+		Use:          cmdName,
+		Short:        cmdShort,
+		Long:         cmdLong,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.Run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.DestDir, "dest-dir", "", "Directory to write to (defaults to must-gather.odh.<timestamp> in the current directory)")
+	cmd.Flags().BoolVar(&o.Archive, "archive", false, "Write a <dest-dir>.tar.gz archive instead of a directory")
+	cmd.Flags().BoolVar(&o.Overwrite, "overwrite", false, "Write into the destination even if it already exists")
+	cmd.Flags().StringArrayVar(&o.RedactPaths, "redact-path", nil, "jq path expression of an additional field to mask (can be repeated)")
+
+	root.AddCommand(cmd)
+}
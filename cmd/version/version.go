@@ -1,14 +1,15 @@
 package version
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 
-	"k8s.io/cli-runtime/pkg/genericclioptions"
-
 	"github.com/lburgazzoli/odh-cli/internal/version"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 const (
@@ -17,49 +18,52 @@ const (
 )
 
 // AddCommand adds the version subcommand to the root command.
-func AddCommand(root *cobra.Command, _ *genericclioptions.ConfigFlags) {
-	var outputFormat string
+// The version is printed as a single line of text by default.
+func AddCommand(root *cobra.Command, _ *utilclient.Flags) {
+	output := printer.NewFlags(printer.Text, printer.TabularCapability, printer.TextCapability)
 
 	cmd := &cobra.Command{
 		Use:          cmdName,
 		Short:        cmdShort,
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			switch outputFormat {
-			case "json":
-				encoder := json.NewEncoder(cmd.OutOrStdout())
-				encoder.SetIndent("", "  ")
-
-				err := encoder.Encode(map[string]string{
-					"version": version.GetVersion(),
-					"commit":  version.GetCommit(),
-					"date":    version.GetDate(),
-				})
-
-				if err != nil {
-					return fmt.Errorf("failed to encode version information as JSON: %w", err)
-				}
+			if err := output.Validate(); err != nil {
+				return err
+			}
 
-				return nil
-			default:
-				_, err := fmt.Fprintf(
-					cmd.OutOrStdout(),
-					"kubectl-odh version %s (commit: %s, built: %s)\n",
-					version.GetVersion(),
-					version.GetCommit(),
-					version.GetDate(),
-				)
+			info := map[string]string{
+				"version": version.GetVersion(),
+				"commit":  version.GetCommit(),
+				"date":    version.GetDate(),
+			}
 
-				if err != nil {
-					return fmt.Errorf("failed to write version information: %w", err)
-				}
+			return output.Print(cmd.OutOrStdout(), printer.Value{
+				Object: info,
+				Rows:   []any{info},
+				Columns: []table.Column{
+					table.NewColumn("VERSION").JQ(`.version`),
+					table.NewColumn("COMMIT").JQ(`.commit`),
+					table.NewColumn("BUILT").JQ(`.date`),
+				},
+				Text: func(w io.Writer) error {
+					_, err := fmt.Fprintf(
+						w,
+						"kubectl-odh version %s (commit: %s, built: %s)\n",
+						version.GetVersion(),
+						version.GetCommit(),
+						version.GetDate(),
+					)
+					if err != nil {
+						return fmt.Errorf("failed to write version information: %w", err)
+					}
 
-				return nil
-			}
+					return nil
+				},
+			})
 		},
 	}
 
-	cmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text|json)")
+	output.AddFlags(cmd.Flags())
 
 	root.AddCommand(cmd)
 }
@@ -0,0 +1,276 @@
+package describe
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const none = "<none>"
+
+type DescribeOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	APIVersion    string
+	AllNamespaces bool
+	Redact        redact.Flags
+
+	componentType string
+
+	client   *utilclient.Client
+	redactor *redact.Redactor
+}
+
+func NewDescribeOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *DescribeOptions {
+	return &DescribeOptions{
+		configFlags: configFlags,
+		streams:     streams,
+	}
+}
+
+func (o *DescribeOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.componentType = args[0]
+	}
+
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
+	return nil
+}
+
+func (o *DescribeOptions) Validate() error {
+	if o.componentType == "" {
+		return fmt.Errorf("component type is required")
+	}
+
+	return nil
+}
+
+func (o *DescribeOptions) Run() error {
+	ctx := context.Background()
+
+	component, err := components.GetComponentByType(
+		ctx,
+		o.client,
+		o.componentType,
+		components.WithAPIVersion(o.APIVersion),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get component: %w", err)
+	}
+
+	namespace := ""
+	if !o.AllNamespaces {
+		namespace, err = dsci.ResolveNamespace(ctx, o.configFlags.ConfigFlags, o.client.Dynamic)
+		if err != nil {
+			return fmt.Errorf("failed to resolve namespace: %w", err)
+		}
+	}
+
+	description, err := components.Describe(ctx, o.client, component, components.WithNamespace(namespace))
+	if err != nil {
+		return fmt.Errorf("failed to describe component: %w", err)
+	}
+
+	description, err = o.redactDescription(description)
+	if err != nil {
+		return fmt.Errorf("failed to redact component description: %w", err)
+	}
+
+	component = description.Component
+
+	out := o.streams.Out
+
+	fmt.Fprintf(out, "Name:         %s\n", component.GetName())
+	fmt.Fprintf(out, "Type:         %s\n", component.GetKind())
+	fmt.Fprintf(out, "API Version:  %s\n", component.GetAPIVersion())
+	fmt.Fprintf(out, "Created:      %s\n", component.GetCreationTimestamp().UTC().Format("2006-01-02 15:04:05 MST"))
+
+	sections := []struct {
+		title  string
+		render func(io.Writer) (int, error)
+	}{
+		{title: "Conditions", render: func(w io.Writer) (int, error) {
+			return renderConditions(w, component)
+		}},
+		{title: "Releases", render: func(w io.Writer) (int, error) {
+			return renderReleases(w, component)
+		}},
+		{title: "Deployments", render: func(w io.Writer) (int, error) {
+			return renderObjects(w, description.Deployments, deploymentColumns()...)
+		}},
+		{title: "Pods", render: func(w io.Writer) (int, error) {
+			return renderObjects(w, description.Pods, podColumns()...)
+		}},
+		{title: "Events", render: func(w io.Writer) (int, error) {
+			return renderObjects(w, description.Events, eventColumns()...)
+		}},
+	}
+
+	for _, section := range sections {
+		fmt.Fprintf(out, "\n%s:\n", section.title)
+
+		count, err := section.render(out)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", section.title, err)
+		}
+
+		if count == 0 {
+			fmt.Fprintf(out, "  %s\n", none)
+		}
+	}
+
+	return nil
+}
+
+// redactDescription returns a copy of the description with each of its objects redacted.
+func (o *DescribeOptions) redactDescription(description *components.Description) (*components.Description, error) {
+	component, err := o.redactor.Unstructured(description.Component)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments, err := o.redactor.Items(description.Deployments)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := o.redactor.Items(description.Pods)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := o.redactor.Items(description.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &components.Description{
+		Component:   component,
+		Deployments: deployments,
+		Pods:        pods,
+		Events:      events,
+	}, nil
+}
+
+func renderConditions(w io.Writer, component *unstructured.Unstructured) (int, error) {
+	items, _, err := unstructured.NestedSlice(component.Object, "status", "conditions")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read status.conditions: %w", err)
+	}
+
+	return renderItems(w, items,
+		table.NewColumn("TYPE").JQ(`.type // ""`),
+		table.NewColumn("STATUS").JQ(`.status // "Unknown"`).Fn(table.StatusFormatter()),
+		table.NewColumn("REASON").JQ(`.reason // ""`),
+		table.NewColumn("AGE").JQ(`.lastTransitionTime // ""`).Fn(table.AgeFormatter()),
+		table.NewColumn("MESSAGE").JQ(`.message // ""`).Wrap(),
+	)
+}
+
+func renderReleases(w io.Writer, component *unstructured.Unstructured) (int, error) {
+	items, _, err := unstructured.NestedSlice(component.Object, "status", "releases")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read status.releases: %w", err)
+	}
+
+	return renderItems(w, items,
+		table.NewColumn("NAME").JQ(`.name // ""`),
+		table.NewColumn("VERSION").JQ(`.version // ""`),
+		table.NewColumn("REPO URL").JQ(`.repoUrl // ""`),
+	)
+}
+
+func deploymentColumns() []table.Column {
+	return []table.Column{
+		table.NewColumn("NAMESPACE").JQ(`.metadata.namespace`),
+		table.NewColumn("NAME").JQ(`.metadata.name`),
+		table.NewColumn("READY").JQ(`"\(.status.readyReplicas // 0)/\(.spec.replicas // 1)"`),
+		table.NewColumn("UP-TO-DATE").JQ(`.status.updatedReplicas // 0`),
+		table.NewColumn("AVAILABLE").JQ(`.status.availableReplicas // 0`),
+		table.NewColumn("AGE").JQ(`.metadata.creationTimestamp // ""`).Fn(table.AgeFormatter()),
+	}
+}
+
+func podColumns() []table.Column {
+	return []table.Column{
+		table.NewColumn("NAMESPACE").JQ(`.metadata.namespace`),
+		table.NewColumn("NAME").JQ(`.metadata.name`),
+		table.NewColumn("READY").JQ(`"\([.status.containerStatuses[]? | select(.ready)] | length)/\(.spec.containers | length)"`),
+		table.NewColumn("STATUS").JQ(`.status.phase // "Unknown"`),
+		table.NewColumn("RESTARTS").JQ(`[.status.containerStatuses[]?.restartCount] | add // 0`),
+		table.NewColumn("AGE").JQ(`.metadata.creationTimestamp // ""`).Fn(table.AgeFormatter()),
+	}
+}
+
+func eventColumns() []table.Column {
+	return []table.Column{
+		table.NewColumn("LAST SEEN").JQ(`.lastTimestamp // .eventTime // .metadata.creationTimestamp // ""`).
+			Fn(table.AgeFormatter()),
+		table.NewColumn("TYPE").JQ(`.type // ""`),
+		table.NewColumn("REASON").JQ(`.reason // ""`),
+		table.NewColumn("OBJECT").JQ(`"\(.involvedObject.kind)/\(.involvedObject.name)"`),
+		table.NewColumn("MESSAGE").JQ(`.message // ""`).Wrap(),
+	}
+}
+
+func renderObjects(w io.Writer, items []unstructured.Unstructured, columns ...table.Column) (int, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	renderer := table.NewWithColumns[unstructured.Unstructured](w, columns...)
+
+	if err := renderer.AppendAll(items); err != nil {
+		return 0, fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	if err := renderer.Render(); err != nil {
+		return 0, err
+	}
+
+	return len(items), nil
+}
+
+func renderItems(w io.Writer, items []any, columns ...table.Column) (int, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	renderer := table.NewWithColumns[any](w, columns...)
+
+	if err := renderer.AppendAll(items); err != nil {
+		return 0, fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	if err := renderer.Render(); err != nil {
+		return 0, err
+	}
+
+	return len(items), nil
+}
@@ -1,31 +1,44 @@
 package disable
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/dependents"
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
 	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
 )
 
 type DisableOptions struct {
-	configFlags *genericclioptions.ConfigFlags
+	configFlags *utilclient.Flags
 	streams     genericclioptions.IOStreams
 
+	Force      bool
+	DryRun     dryrun.Strategy
+	APIVersion string
+
 	componentType string
 
-	client *utilclient.Client
+	client   *utilclient.Client
+	scanners components.DependentScanners
 }
 
 func NewDisableOptions(
 	streams genericclioptions.IOStreams,
-	configFlags *genericclioptions.ConfigFlags,
+	configFlags *utilclient.Flags,
 ) *DisableOptions {
 	return &DisableOptions{
 		configFlags: configFlags,
 		streams:     streams,
+		DryRun:      dryrun.None,
+		scanners:    components.DefaultDependentScanners(),
 	}
 }
 
@@ -53,6 +66,50 @@ func (o *DisableOptions) Validate() error {
 }
 
 func (o *DisableOptions) Run() error {
-	return fmt.Errorf("disable command not yet implemented")
-}
+	ctx := context.Background()
+
+	resource, err := components.ResolveComponentType(
+		o.client,
+		o.componentType,
+		components.WithAPIVersion(o.APIVersion),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resolve component type: %w", err)
+	}
+
+	name := components.ComponentName(resource)
+
+	if err := dependents.Check(ctx, o.client.Dynamic, o.streams.ErrOut, o.scanners, name, o.Force); err != nil {
+		return err
+	}
+
+	cluster, err := dsc.GetDataScienceCluster(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
 
+	patch := dsc.NewManagementStatePatch(cluster, map[string]dsc.ManagementState{
+		name: dsc.Removed,
+	})
+
+	patched, err := dsc.ApplyPatch(ctx, o.client.Dynamic, patch, o.DryRun)
+	if err != nil {
+		return err
+	}
+
+	// The client dry-run prints the patch, the server one the DataScienceCluster it would persist
+	if o.DryRun.Enabled() {
+		yamlData, err := yaml.Marshal(patched.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal dry-run result as YAML: %w", err)
+		}
+
+		fmt.Fprint(o.streams.Out, string(yamlData))
+
+		return nil
+	}
+
+	fmt.Fprintf(o.streams.Out, "component %s disabled\n", name)
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package disable_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/components/disable"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the disabled component.
+const (
+	testDSCName            = "default-dsc"
+	testDSCPhase           = "Ready"
+	testKserveResource     = "kserves"
+	testKserveKind         = "Kserve"
+	testKserve             = "kserve"
+	testInferenceService   = "sklearn-iris"
+	testInferenceNamespace = "models"
+)
+
+func newInferenceService() *unstructured.Unstructured {
+	return clientfake.NewObject(
+		resources.InferenceService.GroupVersion().String(),
+		"InferenceService",
+		testInferenceNamespace,
+		testInferenceService,
+	)
+}
+
+// runDisable disables kserve with the given options and returns the fake dynamic client, so
+// that the resulting DataScienceCluster can be inspected.
+func runDisable(
+	t *testing.T,
+	configure func(o *disable.DisableOptions),
+	objects ...runtime.Object,
+) (*dynamicfake.FakeDynamicClient, *bytes.Buffer, *bytes.Buffer, error) {
+	t.Helper()
+
+	cluster := clientfake.NewDataScienceCluster(testDSCName)
+	cluster.Object["status"] = map[string]any{"phase": testDSCPhase}
+
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithObjects(append(objects, cluster)...),
+	)
+
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+
+	o := disable.NewDisableOptions(genericclioptions.IOStreams{Out: out, ErrOut: errOut}, clientfake.NewFlags(c))
+	configure(o)
+
+	if err := o.Complete(nil, []string{testKserve}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return dynamicClient, out, errOut, o.Run()
+}
+
+// managementState returns the management state of kserve in the DataScienceCluster.
+func managementState(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient) string {
+	t.Helper()
+
+	cluster, err := dsc.GetDataScienceCluster(t.Context(), dynamicClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state, _, _ := unstructured.NestedString(cluster.Object, "spec", "components", testKserve, "managementState")
+
+	return state
+}
+
+func TestDisable(t *testing.T) {
+	t.Run("should remove the component", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, out, _, err := runDisable(t, func(o *disable.DisableOptions) {})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(out.String()).Should(ContainSubstring("component kserve disabled"))
+		g.Expect(managementState(t, dynamicClient)).Should(Equal(string(dsc.Removed)))
+	})
+
+	t.Run("should refuse to remove a component with dependents", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, _, errOut, err := runDisable(t, func(o *disable.DisableOptions) {}, newInferenceService())
+		g.Expect(err).Should(MatchError(ContainSubstring("--force")))
+		g.Expect(errOut.String()).Should(ContainSubstring(testInferenceService))
+		g.Expect(managementState(t, dynamicClient)).Should(BeEmpty())
+	})
+
+	t.Run("should remove a component with dependents with --force", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, _, errOut, err := runDisable(t, func(o *disable.DisableOptions) {
+			o.Force = true
+		}, newInferenceService())
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(errOut.String()).Should(ContainSubstring(testInferenceService))
+		g.Expect(managementState(t, dynamicClient)).Should(Equal(string(dsc.Removed)))
+	})
+
+	t.Run("should only print the patch with --dry-run=client", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, out, _, err := runDisable(t, func(o *disable.DisableOptions) {
+			o.DryRun = dryrun.Client
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(out.String()).Should(ContainSubstring("managementState: Removed"))
+		g.Expect(out.String()).ShouldNot(ContainSubstring("phase"))
+		g.Expect(managementState(t, dynamicClient)).Should(BeEmpty())
+	})
+
+	t.Run("should print the DataScienceCluster returned by the server with --dry-run=server", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, out, _, err := runDisable(t, func(o *disable.DisableOptions) {
+			o.DryRun = dryrun.Server
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(out.String()).Should(ContainSubstring("managementState: Removed"))
+		g.Expect(out.String()).Should(ContainSubstring("phase: " + testDSCPhase))
+	})
+}
@@ -1,19 +1,32 @@
 package enable
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
 	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
 )
 
+const waitInterval = 2 * time.Second
+
 type EnableOptions struct {
-	configFlags *genericclioptions.ConfigFlags
+	configFlags *utilclient.Flags
 	streams     genericclioptions.IOStreams
 
+	Wait       bool
+	Timeout    time.Duration
+	DryRun     dryrun.Strategy
+	APIVersion string
+
 	componentType string
 
 	client *utilclient.Client
@@ -21,11 +34,13 @@ type EnableOptions struct {
 
 func NewEnableOptions(
 	streams genericclioptions.IOStreams,
-	configFlags *genericclioptions.ConfigFlags,
+	configFlags *utilclient.Flags,
 ) *EnableOptions {
 	return &EnableOptions{
 		configFlags: configFlags,
 		streams:     streams,
+		Timeout:     5 * time.Minute,
+		DryRun:      dryrun.None,
 	}
 }
 
@@ -49,10 +64,70 @@ func (o *EnableOptions) Validate() error {
 		return fmt.Errorf("component type is required")
 	}
 
+	if o.Wait && o.DryRun.Enabled() {
+		return fmt.Errorf("--wait cannot be used together with --dry-run")
+	}
+
+	if o.Timeout <= 0 {
+		return fmt.Errorf("timeout must be greater than zero")
+	}
+
 	return nil
 }
 
 func (o *EnableOptions) Run() error {
-	return fmt.Errorf("enable command not yet implemented")
-}
+	ctx := context.Background()
+
+	resource, err := components.ResolveComponentType(
+		o.client,
+		o.componentType,
+		components.WithAPIVersion(o.APIVersion),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to resolve component type: %w", err)
+	}
+
+	cluster, err := dsc.GetDataScienceCluster(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
+
+	name := components.ComponentName(resource)
+	patch := dsc.NewManagementStatePatch(cluster, map[string]dsc.ManagementState{
+		name: dsc.Managed,
+	})
+
+	patched, err := dsc.ApplyPatch(ctx, o.client.Dynamic, patch, o.DryRun)
+	if err != nil {
+		return err
+	}
+
+	// The client dry-run prints the patch, the server one the DataScienceCluster it would persist
+	if o.DryRun.Enabled() {
+		yamlData, err := yaml.Marshal(patched.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal dry-run result as YAML: %w", err)
+		}
+
+		fmt.Fprint(o.streams.Out, string(yamlData))
 
+		return nil
+	}
+
+	fmt.Fprintf(o.streams.Out, "component %s enabled\n", name)
+
+	if !o.Wait {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+
+	if err := components.WaitForReady(waitCtx, o.client, resource, waitInterval); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.streams.Out, "component %s is ready\n", name)
+
+	return nil
+}
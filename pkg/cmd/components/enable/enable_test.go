@@ -0,0 +1,94 @@
+package enable_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/components/enable"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the enabled component.
+const (
+	testDSCName        = "default-dsc"
+	testKserveResource = "kserves"
+	testKserveKind     = "Kserve"
+	testKserve         = "kserve"
+)
+
+// newEnableOptions returns the options enabling kserve, together with the fake dynamic client
+// and the output of the command.
+func newEnableOptions(t *testing.T) (*enable.EnableOptions, *dynamicfake.FakeDynamicClient, *bytes.Buffer) {
+	t.Helper()
+
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName)),
+	)
+
+	out := &bytes.Buffer{}
+
+	o := enable.NewEnableOptions(genericclioptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}, clientfake.NewFlags(c))
+	if err := o.Complete(nil, []string{testKserve}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return o, dynamicClient, out
+}
+
+// managementState returns the management state of kserve in the DataScienceCluster.
+func managementState(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient) string {
+	t.Helper()
+
+	cluster, err := dsc.GetDataScienceCluster(t.Context(), dynamicClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state, _, _ := unstructured.NestedString(cluster.Object, "spec", "components", testKserve, "managementState")
+
+	return state
+}
+
+func TestEnable(t *testing.T) {
+	t.Run("should manage the component", func(t *testing.T) {
+		g := NewWithT(t)
+
+		o, dynamicClient, out := newEnableOptions(t)
+
+		g.Expect(o.Validate()).Should(Succeed())
+		g.Expect(o.Run()).Should(Succeed())
+		g.Expect(out.String()).Should(ContainSubstring("component kserve enabled"))
+		g.Expect(managementState(t, dynamicClient)).Should(Equal(string(dsc.Managed)))
+	})
+
+	t.Run("should only print the patch with --dry-run=client", func(t *testing.T) {
+		g := NewWithT(t)
+
+		o, dynamicClient, out := newEnableOptions(t)
+		o.DryRun = dryrun.Client
+
+		g.Expect(o.Validate()).Should(Succeed())
+		g.Expect(o.Run()).Should(Succeed())
+		g.Expect(out.String()).Should(ContainSubstring("managementState: Managed"))
+		g.Expect(managementState(t, dynamicClient)).Should(BeEmpty())
+	})
+
+	t.Run("should reject --wait with --dry-run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		o, _, _ := newEnableOptions(t)
+		o.DryRun = dryrun.Client
+		o.Wait = true
+
+		g.Expect(o.Validate()).Should(MatchError(ContainSubstring("--wait")))
+	})
+}
@@ -2,35 +2,40 @@ package get
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"sigs.k8s.io/yaml"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
 	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
 )
 
 type GetOptions struct {
-	configFlags *genericclioptions.ConfigFlags
+	configFlags *utilclient.Flags
 	streams     genericclioptions.IOStreams
 
-	OutputFormat  string
+	Output        printer.Flags
+	APIVersion    string
 	componentType string
+	Redact        redact.Flags
 
-	client *utilclient.Client
+	client   *utilclient.Client
+	redactor *redact.Redactor
 }
 
 func NewGetOptions(
 	streams genericclioptions.IOStreams,
-	configFlags *genericclioptions.ConfigFlags,
+	configFlags *utilclient.Flags,
 ) *GetOptions {
 	return &GetOptions{
 		configFlags: configFlags,
 		streams:     streams,
+		Output:      printer.NewFlags(printer.JSON, printer.TabularCapability),
 	}
 }
 
@@ -46,6 +51,11 @@ func (o *GetOptions) Complete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
 	return nil
 }
 
@@ -54,41 +64,41 @@ func (o *GetOptions) Validate() error {
 		return fmt.Errorf("component type is required")
 	}
 
-	validFormats := []string{"json", "yaml"}
-	for _, format := range validFormats {
-		if o.OutputFormat == format {
-			return nil
-		}
-	}
-
-	return fmt.Errorf("unsupported output format: %s (supported: json, yaml)", o.OutputFormat)
+	return o.Output.Validate()
 }
 
 func (o *GetOptions) Run() error {
 	ctx := context.Background()
 
-	component, err := components.GetComponentByType(ctx, o.client, o.componentType)
+	component, err := components.GetComponentByType(
+		ctx,
+		o.client,
+		o.componentType,
+		components.WithAPIVersion(o.APIVersion),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to get component: %w", err)
 	}
 
-	switch o.OutputFormat {
-	case "json":
-		encoder := json.NewEncoder(o.streams.Out)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(component); err != nil {
-			return fmt.Errorf("failed to encode as JSON: %w", err)
-		}
-	case "yaml":
-		yamlData, err := yaml.Marshal(component)
-		if err != nil {
-			return fmt.Errorf("failed to marshal as YAML: %w", err)
-		}
-		fmt.Fprint(o.streams.Out, string(yamlData))
-	default:
-		return fmt.Errorf("unsupported output format: %s (supported: json, yaml)", o.OutputFormat)
+	component, err = o.redactor.Unstructured(component)
+	if err != nil {
+		return fmt.Errorf("failed to redact component: %w", err)
 	}
 
-	return nil
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object: component,
+		Rows:   []any{component},
+		Columns: []table.Column{
+			table.NewColumn("TYPE").
+				JQ(`.kind`),
+			table.NewColumn("NAME").
+				JQ(`.metadata.name`),
+			table.NewColumn("READY").
+				JQ(`.status.conditions[]? | select(.type=="Ready") | .status // "Unknown"`).
+				Fn(table.StatusFormatter()),
+			table.NewColumn("MESSAGE").
+				JQ(`.status.conditions[]? | select(.type=="Ready") | .message // ""`).
+				Wrap(),
+		},
+	})
 }
-
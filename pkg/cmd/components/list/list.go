@@ -2,36 +2,54 @@ package list
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"os"
+	"os/signal"
+	"sort"
 
 	"github.com/spf13/cobra"
-	"sigs.k8s.io/yaml"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
 	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
 	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
 )
 
 type ListOptions struct {
-	configFlags *genericclioptions.ConfigFlags
+	configFlags *utilclient.Flags
 	streams     genericclioptions.IOStreams
 
-	OutputFormat string
+	Output      printer.Flags
+	Watch       bool
+	WatchOnly   bool
+	Strict      bool
+	Concurrency int
+	APIVersion  string
+	SortBy      string
+	Filter      string
+	NoHeaders   bool
+	Redact      redact.Flags
 
-	client *utilclient.Client
+	client   *utilclient.Client
+	redactor *redact.Redactor
+	sortKeys []table.SortKey
 }
 
 func NewListOptions(
 	streams genericclioptions.IOStreams,
-	configFlags *genericclioptions.ConfigFlags,
+	configFlags *utilclient.Flags,
 ) *ListOptions {
 	return &ListOptions{
 		configFlags: configFlags,
 		streams:     streams,
+		Output:      printer.NewFlags(printer.Table, printer.TabularCapability),
+		Concurrency: components.DefaultConcurrency,
 	}
 }
 
@@ -43,67 +61,258 @@ func (o *ListOptions) Complete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
 	return nil
 }
 
 func (o *ListOptions) Validate() error {
-	validFormats := []string{"table", "json", "yaml"}
-	for _, format := range validFormats {
-		if o.OutputFormat == format {
-			return nil
+	if o.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+
+	if err := o.Output.Validate(); err != nil {
+		return err
+	}
+
+	if o.SortBy == "" && o.Filter == "" && !o.NoHeaders {
+		return nil
+	}
+
+	if !o.Output.Tabular() {
+		return fmt.Errorf("--sort-by, --filter and --no-headers are only supported with tabular output formats")
+	}
+
+	if o.SortBy != "" {
+		var err error
+
+		o.sortKeys, err = table.ParseSortKeys(o.SortBy)
+		if err != nil {
+			return fmt.Errorf("invalid --sort-by: %w", err)
+		}
+	}
+
+	if o.Filter != "" {
+		if _, err := jq.Compile(o.Filter, nil); err != nil {
+			return fmt.Errorf("invalid --filter: %w", err)
 		}
 	}
 
-	return fmt.Errorf("unsupported output format: %s (supported: table, json, yaml)", o.OutputFormat)
+	return nil
 }
 
 func (o *ListOptions) Run() error {
 	ctx := context.Background()
 
-	componentList, err := components.ListComponents(ctx, o.client)
+	if o.Watch || o.WatchOnly {
+		return o.runWatch(ctx)
+	}
+
+	componentList, err := components.ListComponents(
+		ctx,
+		o.client,
+		components.WithConcurrency(o.Concurrency),
+		components.WithAPIVersion(o.APIVersion),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to list components: %w", err)
 	}
 
-	switch o.OutputFormat {
-	case "json":
-		encoder := json.NewEncoder(o.streams.Out)
-		encoder.SetIndent("", "  ")
+	if err := o.printList(componentList); err != nil {
+		return err
+	}
 
-		if err := encoder.Encode(componentList); err != nil {
-			return fmt.Errorf("failed to encode components as JSON: %w", err)
-		}
+	return o.checkPartial(componentList)
+}
 
-		return nil
-	case "yaml":
-		yamlData, err := yaml.Marshal(componentList)
+// checkPartial reports partial failures on stderr, so that a component that could not
+// be listed is not mistaken for a missing one, and fails with --strict.
+func (o *ListOptions) checkPartial(componentList *components.ListResult) error {
+	for _, resourceErr := range componentList.Errors {
+		fmt.Fprintf(o.streams.ErrOut, "Warning: %v\n", resourceErr)
+	}
+
+	if o.Strict && componentList.IsPartial() {
+		return fmt.Errorf("failed to list %d component resource(s)", len(componentList.Errors))
+	}
+
+	return nil
+}
+
+func (o *ListOptions) printList(componentList *components.ListResult) error {
+	componentList, err := o.redactList(componentList)
+	if err != nil {
+		return err
+	}
+
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object:       componentList,
+		Rows:         printer.Rows(componentList.Items),
+		Columns:      columns(),
+		TableOptions: o.tableOptions(),
+	})
+}
+
+// redactList returns a copy of the list with each component redacted, and the messages of
+// the resources that could not be listed masked.
+func (o *ListOptions) redactList(componentList *components.ListResult) (*components.ListResult, error) {
+	items, err := o.redactor.Items(componentList.Items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redact components: %w", err)
+	}
+
+	resourceErrors, err := redact.Value(o.redactor, componentList.Errors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redact errors: %w", err)
+	}
+
+	return &components.ListResult{
+		Items:  items,
+		Errors: resourceErrors,
+	}, nil
+}
+
+// runWatch prints the current components (unless --watch-only is set) and then
+// prints updates whenever a component is added, removed or changes readiness.
+// Tabular output prints a row per update, like kubectl does, other formats print each update as
+// a {type, object} event like kubectl get --watch --output-watch-events, the current components
+// being ADDED events.
+// Resources that cannot be listed are reported as in plain list and are not watched, unless
+// --strict is set, in which case the command fails before watching.
+func (o *ListOptions) runWatch(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	watcher, err := components.NewWatcher(o.client, components.WithAPIVersion(o.APIVersion))
+	if err != nil {
+		return fmt.Errorf("failed to watch components: %w", err)
+	}
+
+	componentList, err := watcher.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list components: %w", err)
+	}
+
+	items := componentList.Items
+	if o.WatchOnly {
+		items = nil
+	}
+
+	sort.SliceStable(items, func(i int, j int) bool {
+		return items[i].GetKind()+"/"+items[i].GetName() < items[j].GetKind()+"/"+items[j].GetName()
+	})
+
+	items, err = o.redactor.Items(items)
+	if err != nil {
+		return fmt.Errorf("failed to redact components: %w", err)
+	}
+
+	printEvent := o.printWatchEvent
+
+	if o.Output.Tabular() {
+		stream, err := o.Output.Stream(o.streams.Out, printer.Value{
+			Rows:         printer.Rows(items),
+			Columns:      columns(),
+			TableOptions: o.tableOptions(),
+		})
 		if err != nil {
-			return fmt.Errorf("failed to marshal as YAML: %w", err)
+			return err
 		}
-		fmt.Fprint(o.streams.Out, string(yamlData))
-		return nil
-	case "table":
-		renderer := table.NewWithColumns[unstructured.Unstructured](
-			o.streams.Out,
-			table.NewColumn("TYPE").
-				JQ(`.kind`),
-			table.NewColumn("READY").
-				JQ(`.status.conditions[]? | select(.type=="Ready") | .status // "Unknown"`),
-			table.NewColumn("MESSAGE").
-				JQ(`.status.conditions[]? | select(.type=="Ready") | .message // ""`),
-		)
-
-		if err := renderer.AppendAll(componentList.Items); err != nil {
-			return fmt.Errorf("failed to append rows: %w", err)
+
+		printEvent = func(_ watch.EventType, changed *unstructured.Unstructured) error {
+			if err := stream.Append(*changed); err != nil {
+				return fmt.Errorf("failed to print component: %w", err)
+			}
+
+			return nil
+		}
+	} else {
+		for i := range items {
+			if err := o.printWatchEvent(watch.Added, &items[i]); err != nil {
+				return err
+			}
 		}
+	}
 
-		if err := renderer.Render(); err != nil {
-			return fmt.Errorf("failed to render table: %w", err)
+	if err := o.checkPartial(componentList); err != nil {
+		return err
+	}
+
+	return watcher.Watch(ctx, func(event components.WatchEvent) error {
+		changed, err := o.redactor.Unstructured(event.Object)
+		if err != nil {
+			return fmt.Errorf("failed to redact components: %w", err)
 		}
 
-		return nil
-	default:
-		return fmt.Errorf("unsupported output format: %s (supported: table, json, yaml)", o.OutputFormat)
+		return printEvent(event.Type, changed)
+	})
+}
+
+// printWatchEvent prints a watch event in non tabular formats, in the same form as kubectl
+// get --watch --output-watch-events, so that deletions can be told apart.
+func (o *ListOptions) printWatchEvent(eventType watch.EventType, obj *unstructured.Unstructured) error {
+	if o.Output.OutputFormat.Name() == printer.YAML.Name() {
+		fmt.Fprint(o.streams.Out, "---\n")
 	}
+
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object: map[string]any{
+			"type":   string(eventType),
+			"object": obj.Object,
+		},
+		Rows:    []any{*obj},
+		Columns: columns(),
+	})
 }
 
+func (o *ListOptions) tableOptions() []table.Option[any] {
+	var options []table.Option[any]
+
+	if len(o.sortKeys) > 0 {
+		options = append(options, table.WithSortBy[any](o.sortKeys...))
+	}
+
+	if o.Filter != "" {
+		options = append(options, table.WithFilter[any](o.Filter))
+	}
+
+	if o.NoHeaders {
+		options = append(options, table.WithNoHeaders[any]())
+	}
+
+	return options
+}
+
+// columns returns the columns of the component table, the ones marked as wide are only shown with -o wide.
+func columns() []table.Column {
+	return []table.Column{
+		table.NewColumn("TYPE").
+			JQ(`.kind`),
+		table.NewColumn("NAME").
+			JQ(`.metadata.name`).
+			Wide(),
+		table.NewColumn("READY").
+			JQ(`.status.conditions[]? | select(.type=="Ready") | .status // "Unknown"`).
+			Fn(table.StatusFormatter()),
+		table.NewColumn("REASON").
+			JQ(`.status.conditions[]? | select(.type=="Ready") | .reason // ""`).
+			Wide(),
+		table.NewColumn("RELEASES").
+			JQ(`[.status.releases[]? | "\(.name)@\(.version)"] | if length == 0 then "-" else join(",") end`).
+			Truncate().
+			Wide(),
+		table.NewColumn("OBSERVED GENERATION").
+			JQ(`.status.observedGeneration // "-"`).
+			Wide(),
+		table.NewColumn("AGE").
+			JQ(`.metadata.creationTimestamp // ""`).
+			Fn(table.AgeFormatter()).
+			Wide(),
+		table.NewColumn("MESSAGE").
+			JQ(`.status.conditions[]? | select(.type=="Ready") | .message // ""`).
+			Truncate(),
+	}
+}
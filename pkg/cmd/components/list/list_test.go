@@ -0,0 +1,266 @@
+package list_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/components/get"
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/components/list"
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the listed components.
+const (
+	testKserveResource = "kserves"
+	testKserveKind     = "Kserve"
+	testKserveType     = "kserve"
+	testToken          = "s3cr3t"
+	testRedactPath     = ".spec.auth.token"
+	testRayResource    = "rays"
+	testRayKind        = "Ray"
+	testFailedMessage  = "deployment failed"
+)
+
+func newKserve() *unstructured.Unstructured {
+	kserve := clientfake.NewComponent(testKserveKind, clientfake.NewCondition("Ready", "True", ""))
+	kserve.Object["spec"] = map[string]any{
+		"auth": map[string]any{
+			"token": testToken,
+		},
+	}
+
+	return kserve
+}
+
+// runList runs list with the given options against the client and returns its output.
+func runList(
+	t *testing.T,
+	c *client.Client,
+	configure func(o *list.ListOptions),
+) (*bytes.Buffer, *bytes.Buffer, error) {
+	t.Helper()
+
+	out := &bytes.Buffer{}
+	errOut := &bytes.Buffer{}
+
+	o := list.NewListOptions(genericclioptions.IOStreams{Out: out, ErrOut: errOut}, clientfake.NewFlags(c))
+	configure(o)
+
+	if err := o.Complete(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return out, errOut, o.Run()
+}
+
+// newPartialClient returns a client listing kserve and failing to list rays.
+func newPartialClient() *client.Client {
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithComponent(testRayResource, testRayKind),
+		clientfake.WithObjects(newKserve()),
+	)
+	dynamicClient.PrependReactor("list", testRayResource,
+		func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(clientfake.ComponentGVR(testRayResource).GroupResource(), "", errors.New("denied"))
+		},
+	)
+
+	return c
+}
+
+func TestList(t *testing.T) {
+	t.Run("should report the resources that failed to list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out, errOut, err := runList(t, newPartialClient(), func(o *list.ListOptions) {
+			o.Output.OutputFormat = printer.JSON
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(errOut.String()).Should(ContainSubstring("Warning:"))
+		g.Expect(errOut.String()).Should(ContainSubstring(testRayResource))
+
+		var result components.ListResult
+		g.Expect(json.Unmarshal(out.Bytes(), &result)).Should(Succeed())
+		g.Expect(result.Items).Should(HaveLen(1))
+		g.Expect(result.Errors).Should(HaveLen(1))
+		g.Expect(result.Errors[0].Resource).Should(Equal(testRayResource))
+		g.Expect(result.Errors[0].Reason).Should(Equal(components.ListErrorForbidden))
+	})
+
+	t.Run("should fail on partial results with --strict", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out, _, err := runList(t, newPartialClient(), func(o *list.ListOptions) {
+			o.Output.OutputFormat = printer.JSON
+			o.Strict = true
+		})
+		g.Expect(err).Should(MatchError(ContainSubstring("failed to list 1 component resource(s)")))
+		g.Expect(out.String()).Should(ContainSubstring(testKserveType))
+	})
+
+	t.Run("should not fail with --strict when every resource is listed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := clientfake.NewClient(
+			clientfake.WithComponent(testKserveResource, testKserveKind),
+			clientfake.WithObjects(newKserve()),
+		)
+
+		_, errOut, err := runList(t, c, func(o *list.ListOptions) {
+			o.Strict = true
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(errOut.String()).Should(BeEmpty())
+	})
+}
+
+// newWatchClient returns a client whose first kserve watch replays the events sent by send, and
+// whose second one fails, which ends the command.
+func newWatchClient(send func(w *watch.FakeWatcher)) *client.Client {
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithObjects(newKserve()),
+	)
+
+	watcher := watch.NewFakeWithChanSize(2, false)
+	send(watcher)
+	watcher.Stop()
+
+	watchCalls := 0
+
+	dynamicClient.PrependWatchReactor(testKserveResource,
+		func(action clienttesting.Action) (bool, watch.Interface, error) {
+			watchCalls++
+			if watchCalls > 1 {
+				return true, nil, errors.New("boom")
+			}
+
+			return true, watcher, nil
+		},
+	)
+
+	return c
+}
+
+func TestWatch(t *testing.T) {
+	t.Run("should print a row per update in table format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newWatchClient(func(w *watch.FakeWatcher) {
+			updated := newKserve()
+			clientfake.SetConditions(updated, clientfake.NewCondition("Ready", "False", testFailedMessage))
+			w.Modify(updated)
+		})
+
+		out, _, err := runList(t, c, func(o *list.ListOptions) {
+			o.Watch = true
+		})
+		g.Expect(err).Should(MatchError(ContainSubstring("boom")))
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		g.Expect(lines).Should(HaveLen(3))
+		g.Expect(lines[0]).Should(ContainSubstring("TYPE"))
+		g.Expect(lines[1]).Should(ContainSubstring("True"))
+		g.Expect(lines[2]).Should(ContainSubstring("False"))
+	})
+
+	t.Run("should print typed events in JSON format", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newWatchClient(func(w *watch.FakeWatcher) {
+			w.Delete(newKserve())
+		})
+
+		out, _, err := runList(t, c, func(o *list.ListOptions) {
+			o.Watch = true
+			o.Output.OutputFormat = printer.JSON
+		})
+		g.Expect(err).Should(MatchError(ContainSubstring("boom")))
+
+		var events []map[string]any
+
+		decoder := json.NewDecoder(out)
+		for decoder.More() {
+			var event map[string]any
+			g.Expect(decoder.Decode(&event)).Should(Succeed())
+
+			events = append(events, event)
+		}
+
+		g.Expect(events).Should(HaveLen(2))
+		g.Expect(events[0]).Should(HaveKeyWithValue("type", string(watch.Added)))
+		g.Expect(events[1]).Should(HaveKeyWithValue("type", string(watch.Deleted)))
+		g.Expect(events[1]).Should(HaveKeyWithValue("object", HaveKeyWithValue("kind", testKserveKind)))
+	})
+}
+
+func TestRedactPath(t *testing.T) {
+	c, _ := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithObjects(newKserve()),
+	)
+
+	t.Run("should mask the path in the component printed by get", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out := &bytes.Buffer{}
+
+		o := get.NewGetOptions(genericclioptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}, clientfake.NewFlags(c))
+		o.Output.OutputFormat = printer.JSON
+		o.Redact.Paths = []string{testRedactPath}
+
+		g.Expect(o.Complete(nil, []string{testKserveType})).Should(Succeed())
+		g.Expect(o.Validate()).Should(Succeed())
+		g.Expect(o.Run()).Should(Succeed())
+
+		var component map[string]any
+		g.Expect(json.Unmarshal(out.Bytes(), &component)).Should(Succeed())
+
+		token, _, _ := unstructured.NestedString(component, "spec", "auth", "token")
+		g.Expect(token).Should(Equal(redact.Mask))
+	})
+
+	t.Run("should mask the path in each component printed by list", func(t *testing.T) {
+		g := NewWithT(t)
+
+		out := &bytes.Buffer{}
+
+		o := list.NewListOptions(genericclioptions.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}, clientfake.NewFlags(c))
+		o.Output.OutputFormat = printer.JSON
+		o.Redact.Paths = []string{testRedactPath}
+
+		g.Expect(o.Complete(nil, nil)).Should(Succeed())
+		g.Expect(o.Validate()).Should(Succeed())
+		g.Expect(o.Run()).Should(Succeed())
+
+		var result struct {
+			Items []map[string]any `json:"items"`
+		}
+		g.Expect(json.Unmarshal(out.Bytes(), &result)).Should(Succeed())
+		g.Expect(result.Items).Should(HaveLen(1))
+
+		token, _, _ := unstructured.NestedString(result.Items[0], "spec", "auth", "token")
+		g.Expect(token).Should(Equal(redact.Mask))
+	})
+}
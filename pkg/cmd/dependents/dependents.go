@@ -0,0 +1,49 @@
+// Package dependents checks, before a component is removed, that no user workload still uses it.
+package dependents
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"k8s.io/client-go/dynamic"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+)
+
+// Check warns about the workloads that still use the named component and refuses to
+// proceed unless force is set. Failures to look for them are handled the same way.
+func Check(
+	ctx context.Context,
+	client dynamic.Interface,
+	errOut io.Writer,
+	scanners components.DependentScanners,
+	name string,
+	force bool,
+) error {
+	dependents, err := scanners.Scan(ctx, client, name)
+	if err != nil {
+		if !force {
+			return fmt.Errorf("failed to check resources depending on %s (use --force to skip): %w", name, err)
+		}
+
+		fmt.Fprintf(errOut, "Warning: %v\n", err)
+
+		return nil
+	}
+
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(errOut, "Warning: component %s is still used by %d resource(s):\n", name, len(dependents))
+	for _, d := range dependents {
+		fmt.Fprintf(errOut, "  %s\n", d)
+	}
+
+	if !force {
+		return fmt.Errorf("component %s has dependent resources, use --force to remove it anyway", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/component"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/platform"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+type DoctorOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	Output         printer.Flags
+	Categories     []string
+	StaleThreshold time.Duration
+
+	registry *doctor.Registry
+	client   *utilclient.Client
+}
+
+func NewDoctorOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *DoctorOptions {
+	return &DoctorOptions{
+		configFlags:    configFlags,
+		streams:        streams,
+		Output:         printer.NewFlags(printer.Table, printer.TabularCapability),
+		StaleThreshold: component.DefaultStaleThreshold,
+	}
+}
+
+// newRegistry returns the registry holding all the checks known to the doctor command.
+func (o *DoctorOptions) newRegistry() *doctor.Registry {
+	registry := doctor.NewRegistry()
+	registry.Register(operator.Checks()...)
+	registry.Register(platform.Checks()...)
+	registry.Register(component.Checks(component.WithStaleThreshold(o.StaleThreshold))...)
+
+	return registry
+}
+
+func (o *DoctorOptions) Complete(cmd *cobra.Command, args []string) error {
+	o.registry = o.newRegistry()
+
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return nil
+}
+
+func (o *DoctorOptions) Validate() error {
+	if o.StaleThreshold <= 0 {
+		return fmt.Errorf("stale threshold must be positive")
+	}
+
+	return o.Output.Validate()
+}
+
+func (o *DoctorOptions) Run() error {
+	ctx := context.Background()
+
+	categories := make([]doctor.Category, 0, len(o.Categories))
+	for _, category := range o.Categories {
+		categories = append(categories, doctor.Category(category))
+	}
+
+	results, err := o.registry.Run(ctx, o.client, categories...)
+	if err != nil {
+		return fmt.Errorf("failed to run checks: %w", err)
+	}
+
+	if err := o.printResults(results); err != nil {
+		return err
+	}
+
+	if results.HasErrors() {
+		return fmt.Errorf("%d check(s) failed", results.Summary.Error)
+	}
+
+	return nil
+}
+
+func (o *DoctorOptions) printResults(results *doctor.CheckResults) error {
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object: results,
+		Rows:   printer.Rows(results.Checks),
+		Columns: []table.Column{
+			table.NewColumn("CATEGORY").
+				JQ(`.category`),
+			table.NewColumn("CHECK").
+				JQ(`.name`),
+			table.NewColumn("STATUS").
+				JQ(`.status`).
+				Fn(table.StatusFormatter()),
+			table.NewColumn("MESSAGE").
+				JQ(`.message`).
+				Wrap(),
+		},
+		Footer: func(w io.Writer) error {
+			_, err := fmt.Fprintf(
+				w,
+				"\n%d OK, %d WARNING, %d ERROR\n",
+				results.Summary.OK,
+				results.Summary.Warning,
+				results.Summary.Error,
+			)
+
+			return err
+		},
+	})
+}
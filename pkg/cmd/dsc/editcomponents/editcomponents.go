@@ -0,0 +1,152 @@
+package editcomponents
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/dependents"
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+)
+
+type EditComponentsOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	Force      bool
+	DryRun     dryrun.Strategy
+	APIVersion string
+
+	// changes maps the requested component types to their desired state.
+	changes map[string]dsc.ManagementState
+
+	client   *utilclient.Client
+	scanners components.DependentScanners
+}
+
+func NewEditComponentsOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *EditComponentsOptions {
+	return &EditComponentsOptions{
+		configFlags: configFlags,
+		streams:     streams,
+		DryRun:      dryrun.None,
+		scanners:    components.DefaultDependentScanners(),
+	}
+}
+
+func (o *EditComponentsOptions) Complete(cmd *cobra.Command, args []string) error {
+	o.changes = make(map[string]dsc.ManagementState, len(args))
+
+	for _, arg := range args {
+		componentType, value, ok := strings.Cut(arg, "=")
+		if !ok || componentType == "" {
+			return fmt.Errorf("invalid argument %q: expected <component-type>=<state>", arg)
+		}
+
+		state, err := dsc.ParseManagementState(value)
+		if err != nil {
+			return fmt.Errorf("invalid argument %q: %w", arg, err)
+		}
+
+		o.changes[componentType] = state
+	}
+
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return nil
+}
+
+func (o *EditComponentsOptions) Validate() error {
+	if len(o.changes) == 0 {
+		return fmt.Errorf("at least one <component-type>=<state> argument is required")
+	}
+
+	return nil
+}
+
+func (o *EditComponentsOptions) Run() error {
+	ctx := context.Background()
+
+	states := make(map[string]dsc.ManagementState, len(o.changes))
+
+	for componentType, state := range o.changes {
+		resource, err := components.ResolveComponentType(
+			o.client,
+			componentType,
+			components.WithAPIVersion(o.APIVersion),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to resolve component type: %w", err)
+		}
+
+		name := components.ComponentName(resource)
+		if previous, ok := states[name]; ok && previous != state {
+			return fmt.Errorf("conflicting states requested for component %s", name)
+		}
+
+		states[name] = state
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if states[name] != dsc.Removed {
+			continue
+		}
+
+		if err := dependents.Check(ctx, o.client.Dynamic, o.streams.ErrOut, o.scanners, name, o.Force); err != nil {
+			return err
+		}
+	}
+
+	cluster, err := dsc.GetDataScienceCluster(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
+
+	patch := dsc.NewManagementStatePatch(cluster, states)
+
+	patched, err := dsc.ApplyPatch(ctx, o.client.Dynamic, patch, o.DryRun)
+	if err != nil {
+		return err
+	}
+
+	// The client dry-run prints the patch, the server one the DataScienceCluster it would persist
+	if o.DryRun.Enabled() {
+		yamlData, err := yaml.Marshal(patched.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal dry-run result as YAML: %w", err)
+		}
+
+		fmt.Fprint(o.streams.Out, string(yamlData))
+
+		return nil
+	}
+
+	for _, name := range names {
+		fmt.Fprintf(o.streams.Out, "component %s set to %s\n", name, states[name])
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package editcomponents_test
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/dsc/editcomponents"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the edited DataScienceCluster.
+const (
+	testDSCName            = "default-dsc"
+	testKserveResource     = "kserves"
+	testKserveKind         = "Kserve"
+	testKserve             = "kserve"
+	testDashboardResource  = "dashboards"
+	testDashboardKind      = "Dashboard"
+	testDashboard          = "dashboard"
+	testInferenceService   = "sklearn-iris"
+	testInferenceNamespace = "models"
+)
+
+func newInferenceService() *unstructured.Unstructured {
+	return clientfake.NewObject(
+		resources.InferenceService.GroupVersion().String(),
+		"InferenceService",
+		testInferenceNamespace,
+		testInferenceService,
+	)
+}
+
+// runEditComponents runs edit-components with the given arguments and returns the fake
+// dynamic client, so that the resulting DataScienceCluster can be inspected.
+func runEditComponents(
+	t *testing.T,
+	force bool,
+	objects []runtime.Object,
+	args ...string,
+) (*dynamicfake.FakeDynamicClient, *bytes.Buffer, error) {
+	t.Helper()
+
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithComponent(testDashboardResource, testDashboardKind),
+		clientfake.WithObjects(objects...),
+	)
+
+	errOut := &bytes.Buffer{}
+
+	o := editcomponents.NewEditComponentsOptions(
+		genericclioptions.IOStreams{Out: &bytes.Buffer{}, ErrOut: errOut},
+		clientfake.NewFlags(c),
+	)
+	o.Force = force
+
+	if err := o.Complete(nil, args); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return dynamicClient, errOut, o.Run()
+}
+
+// managementState returns the management state of the component in the DataScienceCluster.
+func managementState(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient, component string) string {
+	t.Helper()
+
+	cluster, err := dsc.GetDataScienceCluster(t.Context(), dynamicClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state, _, _ := unstructured.NestedString(cluster.Object, "spec", "components", component, "managementState")
+
+	return state
+}
+
+func TestEditComponents(t *testing.T) {
+	t.Run("should set the state of every component", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, _, err := runEditComponents(t, false,
+			[]runtime.Object{clientfake.NewDataScienceCluster(testDSCName)},
+			testKserve+"=Removed",
+			testDashboard+"=Managed",
+		)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(managementState(t, dynamicClient, testKserve)).Should(Equal(string(dsc.Removed)))
+		g.Expect(managementState(t, dynamicClient, testDashboard)).Should(Equal(string(dsc.Managed)))
+	})
+
+	t.Run("should refuse to remove a component with dependents", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, errOut, err := runEditComponents(t, false,
+			[]runtime.Object{clientfake.NewDataScienceCluster(testDSCName), newInferenceService()},
+			testKserve+"=Removed",
+			testDashboard+"=Managed",
+		)
+		g.Expect(err).Should(MatchError(ContainSubstring("--force")))
+		g.Expect(errOut.String()).Should(ContainSubstring(testInferenceService))
+		g.Expect(managementState(t, dynamicClient, testKserve)).Should(BeEmpty())
+		g.Expect(managementState(t, dynamicClient, testDashboard)).Should(BeEmpty())
+	})
+
+	t.Run("should remove a component with dependents with --force", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient, errOut, err := runEditComponents(t, true,
+			[]runtime.Object{clientfake.NewDataScienceCluster(testDSCName), newInferenceService()},
+			testKserve+"=Removed",
+		)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(errOut.String()).Should(ContainSubstring(testInferenceService))
+		g.Expect(managementState(t, dynamicClient, testKserve)).Should(Equal(string(dsc.Removed)))
+	})
+
+	t.Run("should not check dependents of managed components", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, errOut, err := runEditComponents(t, false,
+			[]runtime.Object{clientfake.NewDataScienceCluster(testDSCName), newInferenceService()},
+			testKserve+"=Managed",
+		)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(errOut.String()).Should(BeEmpty())
+	})
+}
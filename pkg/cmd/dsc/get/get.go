@@ -0,0 +1,86 @@
+package get
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+type GetOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	Output printer.Flags
+	Redact redact.Flags
+
+	client   *utilclient.Client
+	redactor *redact.Redactor
+}
+
+func NewGetOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *GetOptions {
+	return &GetOptions{
+		configFlags: configFlags,
+		streams:     streams,
+		Output:      printer.NewFlags(printer.JSON, printer.TabularCapability),
+	}
+}
+
+func (o *GetOptions) Complete(cmd *cobra.Command, args []string) error {
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
+	return nil
+}
+
+func (o *GetOptions) Validate() error {
+	return o.Output.Validate()
+}
+
+func (o *GetOptions) Run() error {
+	ctx := context.Background()
+
+	cluster, err := dsc.GetDataScienceCluster(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
+
+	cluster, err = o.redactor.Unstructured(cluster)
+	if err != nil {
+		return fmt.Errorf("failed to redact DataScienceCluster: %w", err)
+	}
+
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object: cluster,
+		Rows:   []any{cluster},
+		Columns: []table.Column{
+			table.NewColumn("NAME").
+				JQ(`.metadata.name`),
+			table.NewColumn("PHASE").
+				JQ(`.status.phase // ""`),
+			table.NewColumn("AGE").
+				JQ(`.metadata.creationTimestamp // ""`).
+				Fn(table.AgeFormatter()),
+		},
+	})
+}
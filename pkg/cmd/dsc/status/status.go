@@ -0,0 +1,113 @@
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+type StatusOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	Output     printer.Flags
+	APIVersion string
+	Redact     redact.Flags
+
+	client   *utilclient.Client
+	redactor *redact.Redactor
+}
+
+func NewStatusOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *StatusOptions {
+	return &StatusOptions{
+		configFlags: configFlags,
+		streams:     streams,
+		Output:      printer.NewFlags(printer.Table, printer.TabularCapability),
+	}
+}
+
+func (o *StatusOptions) Complete(cmd *cobra.Command, args []string) error {
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
+	return nil
+}
+
+func (o *StatusOptions) Validate() error {
+	return o.Output.Validate()
+}
+
+func (o *StatusOptions) Run() error {
+	ctx := context.Background()
+
+	cluster, err := dsc.GetDataScienceCluster(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
+
+	status, err := dsc.GetComponentStatuses(
+		ctx,
+		o.client,
+		cluster,
+		components.WithAPIVersion(o.APIVersion),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get component status: %w", err)
+	}
+
+	// The status holds no object, so only the sensitive strings of the messages are masked.
+	status, err = redact.Value(o.redactor, status)
+	if err != nil {
+		return fmt.Errorf("failed to redact component status: %w", err)
+	}
+
+	err = o.Output.Print(o.streams.Out, printer.Value{
+		Object: status,
+		Rows:   printer.Rows(status.Components),
+		Columns: []table.Column{
+			table.NewColumn("COMPONENT").
+				JQ(`.name`),
+			table.NewColumn("MANAGEMENT STATE").
+				JQ(`.managementState // ""`),
+			table.NewColumn("READY").
+				JQ(`if .deployed or .drift == "Unknown" then .ready else "-" end`).
+				Fn(table.StatusFormatter()),
+			table.NewColumn("DRIFT").
+				JQ(`.drift // ""`),
+			table.NewColumn("MESSAGE").
+				JQ(`.message // ""`).
+				Truncate(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, resourceErr := range status.Errors {
+		fmt.Fprintf(o.streams.ErrOut, "Warning: %v\n", resourceErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package status_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/cmd/dsc/status"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the DataScienceCluster whose status is shown.
+const (
+	testDSCName           = "default-dsc"
+	testKserveResource    = "kserves"
+	testKserveKind        = "Kserve"
+	testKserve            = "kserve"
+	testDashboardResource = "dashboards"
+	testDashboardKind     = "Dashboard"
+	testDashboard         = "dashboard"
+)
+
+func newDSC() *unstructured.Unstructured {
+	cluster := clientfake.NewDataScienceCluster(testDSCName)
+	cluster.Object["spec"] = map[string]any{
+		"components": map[string]any{
+			testKserve:    map[string]any{"managementState": "Managed"},
+			testDashboard: map[string]any{"managementState": "Removed"},
+		},
+	}
+
+	return cluster
+}
+
+// rows returns the rows of the printed table by the value of their first cell.
+func rows(table string) map[string]string {
+	result := map[string]string{}
+
+	for _, line := range strings.Split(table, "\n") {
+		line = strings.Trim(line, "│ ")
+
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			result[fields[0]] = line
+		}
+	}
+
+	return result
+}
+
+func TestStatus(t *testing.T) {
+	t.Run("should show the readiness of components that failed to list as Unknown", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dynamicClient := clientfake.NewClient(
+			clientfake.WithComponent(testKserveResource, testKserveKind),
+			clientfake.WithComponent(testDashboardResource, testDashboardKind),
+			clientfake.WithObjects(newDSC()),
+		)
+		dynamicClient.PrependReactor("list", testKserveResource,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("boom")
+			},
+		)
+
+		out := &bytes.Buffer{}
+		errOut := &bytes.Buffer{}
+
+		o := status.NewStatusOptions(genericclioptions.IOStreams{Out: out, ErrOut: errOut}, clientfake.NewFlags(c))
+
+		g.Expect(o.Complete(nil, nil)).Should(Succeed())
+		g.Expect(o.Validate()).Should(Succeed())
+		g.Expect(o.Run()).Should(Succeed())
+
+		table := rows(out.String())
+		g.Expect(table).Should(HaveKeyWithValue(testKserve, ContainSubstring("Unknown")))
+		g.Expect(table).Should(HaveKeyWithValue(testDashboard, MatchRegexp(`Removed\s+-`)))
+		g.Expect(errOut.String()).Should(ContainSubstring(testKserveResource))
+	})
+}
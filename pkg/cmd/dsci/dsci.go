@@ -0,0 +1,132 @@
+package dsci
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+type DSCIOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	Output printer.Flags
+	Redact redact.Flags
+
+	client   *utilclient.Client
+	redactor *redact.Redactor
+}
+
+func NewDSCIOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *DSCIOptions {
+	return &DSCIOptions{
+		configFlags: configFlags,
+		streams:     streams,
+		Output:      printer.NewFlags(printer.Table, printer.TabularCapability),
+	}
+}
+
+func (o *DSCIOptions) Complete(cmd *cobra.Command, args []string) error {
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	o.redactor, err = o.Redact.Redactor()
+	if err != nil {
+		return fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
+	return nil
+}
+
+func (o *DSCIOptions) Validate() error {
+	return o.Output.Validate()
+}
+
+func (o *DSCIOptions) Run() error {
+	ctx := context.Background()
+
+	initialization, err := dsci.GetDSCInitialization(ctx, o.client.Dynamic)
+	if err != nil {
+		return err
+	}
+
+	initialization, err = o.redactor.Unstructured(initialization)
+	if err != nil {
+		return fmt.Errorf("failed to redact DSCInitialization: %w", err)
+	}
+
+	return o.Output.Print(o.streams.Out, printer.Value{
+		Object: initialization.Object,
+		Rows:   []any{initialization},
+		Columns: []table.Column{
+			table.NewColumn("NAME").
+				JQ(`.metadata.name`),
+			table.NewColumn("APPLICATIONS NAMESPACE").
+				JQ(`.spec.applicationsNamespace // ""`),
+			table.NewColumn("MONITORING").
+				JQ(`.spec.monitoring | if . == null then "-" else "\(.managementState // "-")\(if .namespace then " (\(.namespace))" else "" end)" end`),
+			table.NewColumn("SERVICE MESH").
+				JQ(`.spec.serviceMesh | if . == null then "-" else "\(.managementState // "-")\(if .controlPlane.name then " (\(.controlPlane.namespace // "")/\(.controlPlane.name))" else "" end)" end`),
+			table.NewColumn("TRUSTED CA BUNDLE").
+				JQ(`.spec.trustedCABundle | if . == null then "-" else "\(.managementState // "-")\(if (.customCABundle // "") != "" then " (custom)" else "" end)" end`),
+			table.NewColumn("PHASE").
+				JQ(`.status.phase // ""`),
+		},
+		Footer: func(w io.Writer) error {
+			return renderConditions(w, initialization, o.Output.Color)
+		},
+	})
+}
+
+// renderConditions prints the conditions of the DSCInitialization as a separate section.
+func renderConditions(w io.Writer, initialization *unstructured.Unstructured, color table.ColorMode) error {
+	items, _, err := unstructured.NestedSlice(initialization.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("failed to read status.conditions: %w", err)
+	}
+
+	fmt.Fprintf(w, "\nConditions:\n")
+
+	if len(items) == 0 {
+		fmt.Fprintf(w, "  <none>\n")
+
+		return nil
+	}
+
+	options := table.ColumnOptions[any](
+		table.NewColumn("TYPE").JQ(`.type // ""`),
+		table.NewColumn("STATUS").JQ(`.status // "Unknown"`).Fn(table.StatusFormatter()),
+		table.NewColumn("REASON").JQ(`.reason // ""`),
+		table.NewColumn("AGE").JQ(`.lastTransitionTime // ""`).Fn(table.AgeFormatter()),
+		table.NewColumn("MESSAGE").JQ(`.message // ""`).Wrap(),
+	)
+
+	conditions := table.NewRenderer[any](append(options, table.WithWriter[any](w), table.WithColor[any](color))...)
+
+	if err := conditions.AppendAll(items); err != nil {
+		return fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	if err := conditions.Render(); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
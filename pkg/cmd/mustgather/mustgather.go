@@ -0,0 +1,182 @@
+package mustgather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/mustgather"
+	utilclient "github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// destDirPrefix is the prefix of the default destination, followed by a timestamp.
+const destDirPrefix = "must-gather.odh."
+
+type MustGatherOptions struct {
+	configFlags *utilclient.Flags
+	streams     genericclioptions.IOStreams
+
+	DestDir     string
+	Archive     bool
+	Overwrite   bool
+	RedactPaths []string
+
+	client *utilclient.Client
+}
+
+func NewMustGatherOptions(
+	streams genericclioptions.IOStreams,
+	configFlags *utilclient.Flags,
+) *MustGatherOptions {
+	return &MustGatherOptions{
+		configFlags: configFlags,
+		streams:     streams,
+	}
+}
+
+func (o *MustGatherOptions) Complete(cmd *cobra.Command, args []string) error {
+	if o.DestDir == "" {
+		o.DestDir = destDirPrefix + time.Now().UTC().Format("20060102-150405")
+	}
+
+	var err error
+
+	o.client, err = utilclient.NewClient(o.configFlags)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return nil
+}
+
+func (o *MustGatherOptions) Validate() error {
+	if o.configFlags.Offline() {
+		return fmt.Errorf("must-gather requires a cluster, it cannot run with --from-dir or --from-archive")
+	}
+
+	destination := o.destination()
+
+	_, err := os.Stat(destination)
+	switch {
+	case err == nil && !o.Overwrite:
+		return fmt.Errorf("%s already exists, use --overwrite to write into it", destination)
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("failed to check %s: %w", destination, err)
+	}
+
+	return nil
+}
+
+func (o *MustGatherOptions) Run() error {
+	ctx := context.Background()
+
+	namespace, err := dsci.ResolveNamespace(ctx, o.configFlags.ConfigFlags, o.client.Dynamic)
+	if err != nil {
+		return fmt.Errorf("failed to resolve namespace: %w", err)
+	}
+
+	destination := o.destination()
+
+	// An existing destination is only written into with --overwrite, and is then left in
+	// place on failure rather than removed with the files it held before.
+	_, statErr := os.Stat(destination)
+	existed := statErr == nil
+
+	manifest, err := o.collect(ctx, namespace, destination)
+	if err != nil {
+		if existed {
+			return fmt.Errorf("%w (partial output left in %s)", err, destination)
+		}
+
+		if removeErr := os.RemoveAll(destination); removeErr != nil {
+			return fmt.Errorf("%w (failed to remove partial output in %s: %w)", err, destination, removeErr)
+		}
+
+		return err
+	}
+
+	count := 0
+	for _, captured := range manifest.Captured {
+		count += captured.Count
+	}
+
+	for _, failed := range manifest.Failed {
+		scope := failed.Resource
+		if failed.Namespace != "" {
+			scope = strings.Join([]string{failed.Namespace, failed.Resource}, "/")
+		}
+
+		fmt.Fprintf(o.streams.ErrOut, "Warning: failed to collect %s: %s\n", scope, failed.Error)
+	}
+
+	fmt.Fprintf(o.streams.Out, "Collected %d item(s) into %s\n", count, destination)
+
+	return nil
+}
+
+// destination returns the path of the directory or archive the must-gather is written to.
+func (o *MustGatherOptions) destination() string {
+	destination := filepath.Clean(o.DestDir)
+	if o.Archive {
+		destination += ".tar.gz"
+	}
+
+	return destination
+}
+
+// collect writes the must-gather to destination, as a directory or an archive.
+func (o *MustGatherOptions) collect(
+	ctx context.Context,
+	namespace string,
+	destination string,
+) (_ *mustgather.Manifest, err error) {
+	if !o.Archive {
+		return o.collectInto(ctx, namespace, mustgather.NewDirWriter(destination))
+	}
+
+	file, err := os.Create(destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close archive: %w", closeErr)
+		}
+	}()
+
+	return o.collectInto(ctx, namespace, mustgather.NewArchiveWriter(file, filepath.Base(o.DestDir)))
+}
+
+func (o *MustGatherOptions) collectInto(
+	ctx context.Context,
+	namespace string,
+	writer mustgather.Writer,
+) (*mustgather.Manifest, error) {
+	manifest, err := mustgather.Collect(
+		ctx,
+		o.client,
+		writer,
+		mustgather.WithNamespace(namespace),
+		mustgather.WithRedactPaths(o.RedactPaths...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect must-gather: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
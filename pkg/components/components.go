@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic"
 
 	"github.com/lburgazzoli/odh-cli/pkg/resources"
 	"github.com/lburgazzoli/odh-cli/pkg/util/client"
@@ -17,50 +17,69 @@ import (
 
 // ListComponents lists all component resources from the components.platform.opendatahub.io group.
 // It discovers all resource types in the group and aggregates them into a single list.
+// Resource types are listed in parallel, bounded by the configured concurrency, and the
+// result is ordered by discovery order regardless of which request completes first.
+// Resource types that cannot be listed do not fail the call, they are recorded in
+// the Errors of the returned ListResult instead.
 func ListComponents(
 	ctx context.Context,
 	client *client.Client,
-) (*unstructured.UnstructuredList, error) {
-	// Discover all resources in the components.platform.opendatahub.io group
-	componentResources, err := discoverypkg.GetGroupVersionResources(
-		client.Discovery,
-		resources.Components,
-	)
+	opts ...Option,
+) (*ListResult, error) {
+	options := newOptions(opts...)
+
+	listable, err := discoverComponentResources(client, options)
 	if err != nil {
-		return nil, fmt.Errorf("failed to discover component resources: %w", err)
+		return nil, err
 	}
 
-	// Aggregate all component instances
-	result := &unstructured.UnstructuredList{
-		Items: []unstructured.Unstructured{},
+	// Each worker writes only to its own slot so that the output order
+	// does not depend on the completion order.
+	lists := make([]*unstructured.UnstructuredList, len(listable))
+	errs := make([]error, len(listable))
+
+	semaphore := make(chan struct{}, options.Concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, resource := range listable {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+
+				return
+			}
+
+			lists[i], errs[i] = client.Dynamic.Resource(ComponentGVR(resource)).List(ctx, metav1.ListOptions{})
+		}()
 	}
 
-	// List each resource type and add to the result
-	for _, resource := range componentResources {
-		// Skip subresources (e.g., status, scale)
-		// Subresources have a "/" in their name (e.g., "dashboards/status")
-		if strings.Contains(resource.Name, "/") {
-			continue
-		}
+	wg.Wait()
 
-		// Additional check: skip if this is explicitly marked as a subresource
-		if resource.Kind == "" {
-			continue
-		}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("listing components interrupted: %w", err)
+	}
 
-		gvr := schema.GroupVersionResource{
-			Group:    resources.Components.Group,
-			Version:  resources.Components.Version,
-			Resource: resource.Name,
-		}
+	// Aggregate all component instances
+	result := &ListResult{
+		Items: []unstructured.Unstructured{},
+	}
+
+	for i, resource := range listable {
+		if errs[i] != nil {
+			result.Errors = append(result.Errors, NewResourceError(resource, errs[i]))
 
-		list, err := client.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
-		if err != nil {
-			// Skip resources that can't be listed (e.g., permissions issues)
 			continue
 		}
 
-		result.Items = append(result.Items, list.Items...)
+		result.Items = append(result.Items, lists[i].Items...)
 	}
 
 	return result, nil
@@ -68,19 +87,34 @@ func ListComponents(
 
 // GetComponent retrieves a specific component by name.
 // Components follow a singleton pattern - there should only be one instance per type.
+// The version used to read the component resource is discovered from the server.
 func GetComponent(
 	ctx context.Context,
-	dynamicClient dynamic.Interface,
+	client *client.Client,
 	componentName string,
 	componentResource string,
+	opts ...Option,
 ) (*unstructured.Unstructured, error) {
-	gvr := schema.GroupVersionResource{
-		Group:    resources.Components.Group,
-		Version:  resources.Components.Version,
-		Resource: componentResource,
+	componentResources, err := discoverComponentResources(client, newOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	var gvr schema.GroupVersionResource
+
+	for _, resource := range componentResources {
+		if resource.Name == componentResource {
+			gvr = ComponentGVR(resource)
+
+			break
+		}
+	}
+
+	if gvr.Empty() {
+		return nil, fmt.Errorf("component resource %s not found", componentResource)
 	}
 
-	component, err := dynamicClient.Resource(gvr).Get(ctx, componentName, metav1.GetOptions{})
+	component, err := client.Dynamic.Resource(gvr).Get(ctx, componentName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get component %s: %w", componentName, err)
 	}
@@ -95,14 +129,49 @@ func GetComponentByType(
 	ctx context.Context,
 	client *client.Client,
 	typeName string,
+	opts ...Option,
+) (*unstructured.Unstructured, error) {
+	matchedResource, err := ResolveComponentType(client, typeName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetComponentByResource(ctx, client, matchedResource)
+}
+
+// GetComponentByResource returns the singleton instance of the given component resource type,
+// as returned by ResolveComponentType.
+func GetComponentByResource(
+	ctx context.Context,
+	client *client.Client,
+	resource metav1.APIResource,
 ) (*unstructured.Unstructured, error) {
+	// List instances of the matched resource type
+	list, err := client.Dynamic.Resource(ComponentGVR(resource)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", resource.Name, err)
+	}
+
+	// Return the first instance (singleton pattern)
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("no instances of %s found", resource.Name)
+	}
+
+	return &list.Items[0], nil
+}
+
+// ResolveComponentType finds the component resource type matching the given type name (case-insensitive).
+// Exact matches on the resource name are preferred over partial matches.
+// The returned resource has its Group and Version set to the discovered version.
+func ResolveComponentType(
+	client *client.Client,
+	typeName string,
+	opts ...Option,
+) (metav1.APIResource, error) {
 	// Discover all component resource types
-	componentResources, err := discoverypkg.GetGroupVersionResources(
-		client.Discovery,
-		resources.Components,
-	)
+	componentResources, err := discoverComponentResources(client, newOptions(opts...))
 	if err != nil {
-		return nil, fmt.Errorf("failed to discover component resources: %w", err)
+		return metav1.APIResource{}, err
 	}
 
 	// Find matching resource types (case-insensitive)
@@ -112,11 +181,6 @@ func GetComponentByType(
 	lowerTypeName := strings.ToLower(typeName)
 
 	for _, resource := range componentResources {
-		// Skip subresources
-		if strings.Contains(resource.Name, "/") || resource.Kind == "" {
-			continue
-		}
-
 		lowerResourceName := strings.ToLower(resource.Name)
 
 		// Check for exact match
@@ -129,40 +193,82 @@ func GetComponentByType(
 	}
 
 	// Prefer exact matches
-	var matchedResource metav1.APIResource
-	if len(exactMatches) == 1 {
-		matchedResource = exactMatches[0]
-	} else if len(exactMatches) > 1 {
-		return nil, fmt.Errorf("ambiguous component type %q: multiple exact matches found", typeName)
-	} else if len(partialMatches) == 1 {
-		matchedResource = partialMatches[0]
-	} else if len(partialMatches) > 1 {
+	switch {
+	case len(exactMatches) == 1:
+		return exactMatches[0], nil
+	case len(exactMatches) > 1:
+		return metav1.APIResource{}, fmt.Errorf("ambiguous component type %q: multiple exact matches found", typeName)
+	case len(partialMatches) == 1:
+		return partialMatches[0], nil
+	case len(partialMatches) > 1:
 		// List the matching types
 		var matchNames []string
 		for _, m := range partialMatches {
 			matchNames = append(matchNames, m.Name)
 		}
-		return nil, fmt.Errorf("ambiguous component type %q: matches %v", typeName, matchNames)
-	} else {
-		return nil, fmt.Errorf("no component type matching %q found", typeName)
+
+		return metav1.APIResource{}, fmt.Errorf("ambiguous component type %q: matches %v", typeName, matchNames)
+	default:
+		return metav1.APIResource{}, fmt.Errorf("no component type matching %q found", typeName)
 	}
+}
 
-	// List instances of the matched resource type
-	gvr := schema.GroupVersionResource{
-		Group:    resources.Components.Group,
-		Version:  resources.Components.Version,
-		Resource: matchedResource.Name,
+// DiscoverResources returns the component resource types served by the cluster, each one
+// resolved to the server preferred version unless an API version is configured.
+func DiscoverResources(
+	client *client.Client,
+	opts ...Option,
+) ([]metav1.APIResource, error) {
+	return discoverComponentResources(client, newOptions(opts...))
+}
+
+// ComponentName returns the name used to reference the component in the DataScienceCluster
+// spec (spec.components.<name>), which is the lowercased kind of the component resource.
+func ComponentName(resource metav1.APIResource) string {
+	return strings.ToLower(resource.Kind)
+}
+
+// ComponentGVR returns the GroupVersionResource of a discovered component resource.
+func ComponentGVR(resource metav1.APIResource) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    resource.Group,
+		Version:  resource.Version,
+		Resource: resource.Name,
 	}
+}
 
-	list, err := client.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+// discoverComponentResources returns the component resource types served by the cluster,
+// each one resolved to the server preferred version unless an API version is configured.
+func discoverComponentResources(
+	client *client.Client,
+	options Options,
+) ([]metav1.APIResource, error) {
+	// Discover all resources in the components.platform.opendatahub.io group
+	componentResources, err := discoverypkg.GetPreferredGroupResources(
+		client.Discovery,
+		resources.ComponentsGroup,
+		options.APIVersion,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list %s: %w", matchedResource.Name, err)
+		return nil, fmt.Errorf("failed to discover component resources: %w", err)
 	}
 
-	// Return the first instance (singleton pattern)
-	if len(list.Items) == 0 {
-		return nil, fmt.Errorf("no instances of %s found", matchedResource.Name)
+	result := make([]metav1.APIResource, 0, len(componentResources))
+
+	for _, resource := range componentResources {
+		// Skip subresources (e.g., status, scale)
+		// Subresources have a "/" in their name (e.g., "dashboards/status")
+		if strings.Contains(resource.Name, "/") {
+			continue
+		}
+
+		// Additional check: skip if this is explicitly marked as a subresource
+		if resource.Kind == "" {
+			continue
+		}
+
+		result = append(result, resource)
 	}
 
-	return &list.Items[0], nil
+	return result, nil
 }
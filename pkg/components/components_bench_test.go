@@ -0,0 +1,141 @@
+package components_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for concurrent listing.
+const (
+	testComponentKinds = 12
+	testListLatency    = 5 * time.Millisecond
+)
+
+// latencyClient wraps a dynamic client and delays every List call, simulating a remote cluster.
+// The latency is computed per resource so that tests can control completion order.
+type latencyClient struct {
+	dynamic.Interface
+
+	latency func(gvr schema.GroupVersionResource) time.Duration
+}
+
+func (c latencyClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return latencyResource{
+		NamespaceableResourceInterface: c.Interface.Resource(gvr),
+		latency:                        c.latency(gvr),
+	}
+}
+
+type latencyResource struct {
+	dynamic.NamespaceableResourceInterface
+
+	latency time.Duration
+}
+
+func (r latencyResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	select {
+	case <-time.After(r.latency):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return r.NamespaceableResourceInterface.List(ctx, opts)
+}
+
+func componentKind(i int) string {
+	return fmt.Sprintf("Component%02d", i)
+}
+
+func componentResource(i int) string {
+	return fmt.Sprintf("component%02ds", i)
+}
+
+// newLatencyClient creates a client serving testComponentKinds component types, each with one instance.
+func newLatencyClient(latency func(gvr schema.GroupVersionResource) time.Duration) *client.Client {
+	opts := make([]clientfake.Option, 0, testComponentKinds)
+
+	for i := range testComponentKinds {
+		opts = append(opts,
+			clientfake.WithComponent(componentResource(i), componentKind(i)),
+			clientfake.WithObjects(&unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": clientfake.ComponentsAPIVersion,
+					"kind":       componentKind(i),
+					"metadata": map[string]any{
+						"name": "default-" + componentResource(i),
+					},
+				},
+			}),
+		)
+	}
+
+	c, dynamicClient := clientfake.NewClient(opts...)
+	c.Dynamic = latencyClient{Interface: dynamicClient, latency: latency}
+
+	return c
+}
+
+func TestListComponentsConcurrently(t *testing.T) {
+	t.Run("should keep discovery order regardless of completion order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// Earlier resources are slower, so they complete last.
+		c := newLatencyClient(func(gvr schema.GroupVersionResource) time.Duration {
+			var index int
+			_, _ = fmt.Sscanf(gvr.Resource, "component%02ds", &index)
+
+			return time.Duration(testComponentKinds-index) * time.Millisecond
+		})
+
+		result, err := components.ListComponents(t.Context(), c, components.WithConcurrency(testComponentKinds))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.Items).Should(HaveLen(testComponentKinds))
+
+		for i := range testComponentKinds {
+			g.Expect(result.Items[i].GetKind()).Should(Equal(componentKind(i)))
+		}
+	})
+
+	t.Run("should stop when the context is cancelled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newLatencyClient(func(schema.GroupVersionResource) time.Duration {
+			return time.Minute
+		})
+
+		ctx, cancel := context.WithTimeout(t.Context(), testListLatency)
+		defer cancel()
+
+		_, err := components.ListComponents(ctx, c, components.WithConcurrency(2))
+		g.Expect(err).Should(MatchError(context.DeadlineExceeded))
+	})
+}
+
+func BenchmarkListComponents(b *testing.B) {
+	c := newLatencyClient(func(schema.GroupVersionResource) time.Duration {
+		return testListLatency
+	})
+
+	for _, concurrency := range []int{1, 4, testComponentKinds} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := components.ListComponents(b.Context(), c, components.WithConcurrency(concurrency)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package components
+
+import (
+	"github.com/lburgazzoli/odh-cli/pkg/util"
+)
+
+const (
+	// DefaultConcurrency is the default number of component resource types listed in parallel.
+	DefaultConcurrency = 4
+	// DefaultEventLimit is the default number of most recent events included in a component description.
+	DefaultEventLimit = 20
+)
+
+// Options holds the configuration for component lookups.
+type Options struct {
+	// Concurrency is the maximum number of component resource types listed in parallel.
+	Concurrency int
+	// APIVersion forces the version of the components API group, the server
+	// preferred version of each resource is used when empty.
+	APIVersion string
+	// Namespace restricts the lookup of namespace-scoped resources owned by a
+	// component, all namespaces are searched when empty.
+	Namespace string
+	// EventLimit is the maximum number of most recent events included in a component description.
+	EventLimit int
+}
+
+// Option is a functional option for configuring component lookups.
+type Option = util.Option[Options]
+
+// WithConcurrency sets the maximum number of component resource types listed in parallel.
+// Values lower than one are ignored.
+func WithConcurrency(concurrency int) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		if concurrency > 0 {
+			o.Concurrency = concurrency
+		}
+	})
+}
+
+// WithAPIVersion forces the version of the components API group instead of
+// the server preferred version.
+func WithAPIVersion(version string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.APIVersion = version
+	})
+}
+
+// WithNamespace restricts the lookup of namespace-scoped resources owned by a
+// component to the given namespace.
+func WithNamespace(namespace string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.Namespace = namespace
+	})
+}
+
+// WithEventLimit sets the maximum number of most recent events included in a component
+// description. Values lower than one are ignored.
+func WithEventLimit(limit int) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		if limit > 0 {
+			o.EventLimit = limit
+		}
+	})
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		Concurrency: DefaultConcurrency,
+		EventLimit:  DefaultEventLimit,
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(&options)
+	}
+
+	return options
+}
@@ -0,0 +1,177 @@
+package components_test
+
+import (
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the components API group.
+const (
+	testComponentsNextVersion    = "v1"
+	testComponentsNextAPIVersion = resources.ComponentsGroup + "/" + testComponentsNextVersion
+	testDashboardResource        = "dashboards"
+	testDashboardKind            = "Dashboard"
+	testUnservedVersion          = "v2"
+)
+
+// newMultiVersionClient serves kserves in both versions, with the newer one preferred,
+// and dashboards only in the older one.
+func newMultiVersionClient() *client.Client {
+	c, _ := clientfake.NewClient(
+		clientfake.WithDiscovery(testComponentsNextAPIVersion,
+			metav1.APIResource{Name: testKserveResource, Kind: testKserveKind},
+		),
+		clientfake.WithDiscovery(clientfake.ComponentsAPIVersion,
+			metav1.APIResource{Name: testKserveResource, Kind: testKserveKind},
+			metav1.APIResource{Name: testDashboardResource, Kind: testDashboardKind},
+		),
+	)
+
+	return c
+}
+
+// failingDiscovery fails to discover the resources of one group version.
+type failingDiscovery struct {
+	discovery.DiscoveryInterface
+
+	groupVersion string
+}
+
+func (d *failingDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if groupVersion == d.groupVersion {
+		return nil, apierrors.NewServiceUnavailable("boom")
+	}
+
+	return d.DiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+}
+
+// newFailingVersionClient returns a client built by newMultiVersionClient whose discovery of the
+// given group version fails.
+func newFailingVersionClient(groupVersion string) *client.Client {
+	c := newMultiVersionClient()
+	c.Discovery = &failingDiscovery{DiscoveryInterface: c.Discovery, groupVersion: groupVersion}
+
+	return c
+}
+
+func TestListComponents(t *testing.T) {
+	t.Run("should list all components", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newComponentsClient(newKserve("True", testReadyMessage))
+
+		result, err := components.ListComponents(t.Context(), c)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.IsPartial()).Should(BeFalse())
+		g.Expect(result.Items).Should(HaveLen(1))
+		g.Expect(result.Items[0].GetName()).Should(Equal(testKserveInstanceName))
+	})
+
+	t.Run("should record resources that cannot be listed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dynamicClient := newComponentsClient(newKserve("True", testReadyMessage))
+		dynamicClient.PrependReactor("list", testKserveResource,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(
+					schema.GroupResource{Resource: testKserveResource}, "", errors.New("denied"),
+				)
+			},
+		)
+
+		result, err := components.ListComponents(t.Context(), c)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.Items).Should(BeEmpty())
+		g.Expect(result.IsPartial()).Should(BeTrue())
+		g.Expect(result.Errors).Should(HaveLen(1))
+		g.Expect(result.Errors[0].Resource).Should(Equal(testKserveResource))
+		g.Expect(result.Errors[0].Component).Should(Equal("kserve"))
+		g.Expect(result.Errors[0].Reason).Should(Equal(components.ListErrorForbidden))
+		g.Expect(apierrors.IsForbidden(result.Errors[0])).Should(BeTrue())
+	})
+}
+
+func TestResolveComponentType(t *testing.T) {
+	c := newMultiVersionClient()
+
+	t.Run("should use the server preferred version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resource, err := components.ResolveComponentType(c, "kserve")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(resource.Name).Should(Equal(testKserveResource))
+		g.Expect(resource.Version).Should(Equal(testComponentsNextVersion))
+		g.Expect(components.ComponentGVR(resource).GroupVersion().String()).Should(Equal(testComponentsNextAPIVersion))
+	})
+
+	t.Run("should fall back to other versions for resources missing in the preferred one", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resource, err := components.ResolveComponentType(c, "dashboard")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(resource.Version).Should(Equal(clientfake.ComponentsVersion))
+		g.Expect(components.ComponentName(resource)).Should(Equal("dashboard"))
+	})
+
+	t.Run("should honor an explicit API version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resource, err := components.ResolveComponentType(c, "kserve", components.WithAPIVersion(clientfake.ComponentsVersion))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(resource.Version).Should(Equal(clientfake.ComponentsVersion))
+	})
+
+	t.Run("should fail for an API version that is not served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := components.ResolveComponentType(c, "kserve", components.WithAPIVersion(testUnservedVersion))
+		g.Expect(err).Should(MatchError(ContainSubstring("is not served")))
+	})
+
+	t.Run("should fail for unknown types", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := components.ResolveComponentType(c, "unknown")
+		g.Expect(err).Should(MatchError(ContainSubstring("no component type matching")))
+	})
+
+	t.Run("should skip non-preferred versions that fail discovery", func(t *testing.T) {
+		g := NewWithT(t)
+
+		resource, err := components.ResolveComponentType(newFailingVersionClient(clientfake.ComponentsAPIVersion), "kserve")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(resource.Version).Should(Equal(testComponentsNextVersion))
+	})
+
+	t.Run("should fail when the preferred version fails discovery", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := components.ResolveComponentType(newFailingVersionClient(testComponentsNextAPIVersion), "kserve")
+		g.Expect(err).Should(MatchError(ContainSubstring("failed to discover resources for " + testComponentsNextAPIVersion)))
+	})
+
+	t.Run("should fail when an explicit API version fails discovery", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := components.ResolveComponentType(
+			newFailingVersionClient(clientfake.ComponentsAPIVersion),
+			"kserve",
+			components.WithAPIVersion(clientfake.ComponentsVersion),
+		)
+		g.Expect(err).Should(MatchError(ContainSubstring("failed to discover resources for " + clientfake.ComponentsAPIVersion)))
+	})
+}
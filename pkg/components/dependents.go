@@ -0,0 +1,112 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+)
+
+// Dependent identifies a user workload that relies on a component.
+type Dependent struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// String returns the dependent in kind/namespace/name form.
+func (d Dependent) String() string {
+	if d.Namespace == "" {
+		return d.Kind + "/" + d.Name
+	}
+
+	return d.Kind + "/" + d.Namespace + "/" + d.Name
+}
+
+// DependentScanner finds user workloads that depend on a component.
+type DependentScanner interface {
+	Scan(ctx context.Context, client dynamic.Interface) ([]Dependent, error)
+}
+
+// DependentScannerFunc adapts a function to the DependentScanner interface.
+type DependentScannerFunc func(ctx context.Context, client dynamic.Interface) ([]Dependent, error)
+
+// Scan implements the DependentScanner interface.
+func (f DependentScannerFunc) Scan(ctx context.Context, client dynamic.Interface) ([]Dependent, error) {
+	return f(ctx, client)
+}
+
+// ResourceScanner returns a DependentScanner that reports every instance of the given resources
+// across all namespaces. Resources whose API is not served by the cluster are ignored.
+func ResourceScanner(gvrs ...schema.GroupVersionResource) DependentScanner {
+	return DependentScannerFunc(func(ctx context.Context, client dynamic.Interface) ([]Dependent, error) {
+		var result []Dependent
+
+		for _, gvr := range gvrs {
+			list, err := client.Resource(gvr).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+
+				return nil, fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err)
+			}
+
+			for _, item := range list.Items {
+				result = append(result, Dependent{
+					Kind:      item.GetKind(),
+					Namespace: item.GetNamespace(),
+					Name:      item.GetName(),
+				})
+			}
+		}
+
+		return result, nil
+	})
+}
+
+// DependentScanners maps component names (as used in the DataScienceCluster spec)
+// to the scanner that finds the workloads depending on them.
+type DependentScanners map[string]DependentScanner
+
+// DefaultDependentScanners returns the scanners for the workloads known to depend on
+// the built-in ODH/RHOAI components.
+func DefaultDependentScanners() DependentScanners {
+	return DependentScanners{
+		"workbenches":          ResourceScanner(resources.Notebook),
+		"kserve":               ResourceScanner(resources.InferenceService),
+		"datasciencepipelines": ResourceScanner(resources.DataSciencePipelinesApplication),
+		"ray":                  ResourceScanner(resources.RayCluster),
+		"modelregistry":        ResourceScanner(resources.ModelRegistry),
+	}
+}
+
+// Scan runs the scanner registered for the given component, if any, and returns the
+// dependents sorted by kind, namespace and name.
+func (s DependentScanners) Scan(
+	ctx context.Context,
+	client dynamic.Interface,
+	componentName string,
+) ([]Dependent, error) {
+	scanner, ok := s[componentName]
+	if !ok {
+		return []Dependent{}, nil
+	}
+
+	dependents, err := scanner.Scan(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan dependents of %s: %w", componentName, err)
+	}
+
+	sort.Slice(dependents, func(i int, j int) bool {
+		return dependents[i].String() < dependents[j].String()
+	})
+
+	return dependents, nil
+}
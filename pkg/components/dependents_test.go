@@ -0,0 +1,108 @@
+package components_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for dependent resource scanning.
+const (
+	testNotebookKind      = "Notebook"
+	testNotebookNamespace = "ds-project"
+	testNotebookName1     = "my-workbench"
+	testNotebookName2     = "another-workbench"
+	testWorkbenches       = "workbenches"
+	testUnknownComponent  = "dashboard"
+)
+
+func newNotebook(namespace string, name string) *unstructured.Unstructured {
+	return clientfake.NewObject(resources.Notebook.GroupVersion().String(), testNotebookKind, namespace, name)
+}
+
+func TestDependentScanners(t *testing.T) {
+	t.Run("should report dependents sorted by identity", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(
+			newNotebook(testNotebookNamespace, testNotebookName1),
+			newNotebook(testNotebookNamespace, testNotebookName2),
+		))
+
+		dependents, err := components.DefaultDependentScanners().Scan(t.Context(), client, testWorkbenches)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(dependents).Should(Equal([]components.Dependent{
+			{Kind: testNotebookKind, Namespace: testNotebookNamespace, Name: testNotebookName2},
+			{Kind: testNotebookKind, Namespace: testNotebookNamespace, Name: testNotebookName1},
+		}))
+	})
+
+	t.Run("should report nothing for components without a scanner", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(newNotebook(testNotebookNamespace, testNotebookName1)))
+
+		dependents, err := components.DefaultDependentScanners().Scan(t.Context(), client, testUnknownComponent)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(dependents).Should(BeEmpty())
+	})
+
+	t.Run("should ignore resources that are not served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient()
+		client.PrependReactor("list", resources.Notebook.Resource,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewNotFound(resources.Notebook.GroupResource(), "")
+			},
+		)
+
+		dependents, err := components.DefaultDependentScanners().Scan(t.Context(), client, testWorkbenches)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(dependents).Should(BeEmpty())
+	})
+
+	t.Run("should propagate list failures", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient()
+		client.PrependReactor("list", resources.Notebook.Resource,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(resources.Notebook.GroupResource(), "", errors.New("denied"))
+			},
+		)
+
+		_, err := components.DefaultDependentScanners().Scan(t.Context(), client, testWorkbenches)
+		g.Expect(err).Should(HaveOccurred())
+		g.Expect(apierrors.IsForbidden(err)).Should(BeTrue())
+	})
+
+	t.Run("should support custom scanners", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scanners := components.DependentScanners{
+			testUnknownComponent: components.DependentScannerFunc(
+				func(ctx context.Context, client dynamic.Interface) ([]components.Dependent, error) {
+					return []components.Dependent{{Kind: testNotebookKind, Name: testNotebookName1}}, nil
+				},
+			),
+		}
+
+		dependents, err := scanners.Scan(t.Context(), clientfake.NewDynamicClient(), testUnknownComponent)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(dependents).Should(HaveLen(1))
+		g.Expect(dependents[0].String()).Should(Equal(testNotebookKind + "/" + testNotebookName1))
+	})
+}
@@ -0,0 +1,156 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// Description aggregates a component together with the resources it owns and the related events.
+type Description struct {
+	Component   *unstructured.Unstructured
+	Deployments []unstructured.Unstructured
+	Pods        []unstructured.Unstructured
+	Events      []unstructured.Unstructured
+}
+
+// Describe collects the Deployments owned by the given component, the Pods backing them
+// and the most recent Events recorded for any of those objects, oldest first.
+// Deployments are looked up in the configured namespace, or in all namespaces when none is set.
+// Events are listed with a field selector on the UID of each object, in the namespace of the
+// object; the events of the component, which is cluster-scoped, are recorded in the default
+// namespace.
+func Describe(
+	ctx context.Context,
+	client *client.Client,
+	component *unstructured.Unstructured,
+	opts ...Option,
+) (*Description, error) {
+	options := newOptions(opts...)
+
+	result := &Description{
+		Component:   component,
+		Deployments: []unstructured.Unstructured{},
+		Pods:        []unstructured.Unstructured{},
+		Events:      []unstructured.Unstructured{},
+	}
+
+	deployments, err := client.Dynamic.Resource(resources.Deployment).
+		Namespace(options.Namespace).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	// eventNamespaces maps the UID of each object to the namespace its events are recorded in.
+	eventNamespaces := map[types.UID]string{
+		component.GetUID(): metav1.NamespaceDefault,
+	}
+
+	for _, deployment := range deployments.Items {
+		if !isOwnedBy(&deployment, component.GetUID()) {
+			continue
+		}
+
+		result.Deployments = append(result.Deployments, deployment)
+		eventNamespaces[deployment.GetUID()] = deployment.GetNamespace()
+
+		pods, err := listDeploymentPods(ctx, client, &deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range pods {
+			result.Pods = append(result.Pods, pod)
+			eventNamespaces[pod.GetUID()] = pod.GetNamespace()
+		}
+	}
+
+	for _, uid := range sets.List(sets.KeySet(eventNamespaces)) {
+		events, err := client.Dynamic.Resource(resources.Event).
+			Namespace(eventNamespaces[uid]).
+			List(ctx, metav1.ListOptions{FieldSelector: "involvedObject.uid=" + string(uid)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %w", err)
+		}
+
+		result.Events = append(result.Events, events.Items...)
+	}
+
+	sort.SliceStable(result.Events, func(i int, j int) bool {
+		return eventTime(&result.Events[i]) < eventTime(&result.Events[j])
+	})
+
+	if len(result.Events) > options.EventLimit {
+		result.Events = result.Events[len(result.Events)-options.EventLimit:]
+	}
+
+	return result, nil
+}
+
+// listDeploymentPods lists the pods matched by the selector of the given deployment.
+func listDeploymentPods(
+	ctx context.Context,
+	client *client.Client,
+	deployment *unstructured.Unstructured,
+) ([]unstructured.Unstructured, error) {
+	rawSelector, found, err := unstructured.NestedMap(deployment.Object, "spec", "selector")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read selector of deployment %s: %w", deployment.GetName(), err)
+	}
+
+	if !found {
+		return []unstructured.Unstructured{}, nil
+	}
+
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, &labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to decode selector of deployment %s: %w", deployment.GetName(), err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s: %w", deployment.GetName(), err)
+	}
+
+	pods, err := client.Dynamic.Resource(resources.Pod).
+		Namespace(deployment.GetNamespace()).
+		List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of deployment %s: %w", deployment.GetName(), err)
+	}
+
+	return pods.Items, nil
+}
+
+// isOwnedBy reports whether the object has an owner reference with the given UID.
+func isOwnedBy(obj *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// eventTime returns the most relevant timestamp of an event in RFC3339 form, which sorts lexically.
+func eventTime(event *unstructured.Unstructured) string {
+	for _, field := range []string{"lastTimestamp", "eventTime", "firstTimestamp"} {
+		if v, ok, _ := unstructured.NestedString(event.Object, field); ok && v != "" {
+			return v
+		}
+	}
+
+	return event.GetCreationTimestamp().UTC().Format(time.RFC3339)
+}
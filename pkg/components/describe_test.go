@@ -0,0 +1,240 @@
+package components_test
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for component description.
+const (
+	testComponentUID       = "component-uid"
+	testDeploymentUID      = "deployment-uid"
+	testOtherUID           = "other-uid"
+	testPodUID             = "pod-uid"
+	testAppsNamespace      = "opendatahub"
+	testOtherNamespace     = "other"
+	testDeploymentName     = "kserve-controller-manager"
+	testOtherDeployment    = "unrelated"
+	testPodName            = "kserve-controller-manager-abc"
+	testAppLabel           = "kserve"
+	testComponentEvent     = "component-event"
+	testPodEvent           = "pod-event"
+	testUnrelatedEvent     = "unrelated-event"
+	testEarlierEventTime   = "2025-01-01T10:00:00Z"
+	testLaterEventTime     = "2025-01-01T11:00:00Z"
+	testKserveKind         = "Kserve"
+	testKserveInstanceName = "default-kserve"
+)
+
+func newDescribeDeployment(name string, uid string, ownerUID string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"namespace": testAppsNamespace,
+				"name":      name,
+				"uid":       uid,
+				"ownerReferences": []any{
+					map[string]any{
+						"apiVersion": clientfake.ComponentsAPIVersion,
+						"kind":       testKserveKind,
+						"name":       testKserveInstanceName,
+						"uid":        ownerUID,
+					},
+				},
+			},
+			"spec": map[string]any{
+				"selector": map[string]any{
+					"matchLabels": map[string]any{"app": testAppLabel},
+				},
+			},
+		},
+	}
+}
+
+func newDescribePod() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"namespace": testAppsNamespace,
+				"name":      testPodName,
+				"uid":       testPodUID,
+				"labels":    map[string]any{"app": testAppLabel},
+			},
+		},
+	}
+}
+
+func newDescribeEvent(namespace string, name string, involvedUID string, timestamp string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]any{
+				"namespace": namespace,
+				"name":      name,
+			},
+			"involvedObject": map[string]any{
+				"uid": involvedUID,
+			},
+			"lastTimestamp": timestamp,
+		},
+	}
+}
+
+// honorEventFieldSelectors makes the fake client filter events by the field selector of list
+// requests, which it ignores otherwise.
+func honorEventFieldSelectors(dynamicClient *dynamicfake.FakeDynamicClient) {
+	dynamicClient.PrependReactor("list", resources.Event.Resource,
+		func(action clienttesting.Action) (bool, runtime.Object, error) {
+			listAction, ok := action.(clienttesting.ListAction)
+			if !ok {
+				return false, nil, nil
+			}
+
+			obj, err := dynamicClient.Tracker().List(
+				resources.Event,
+				resources.Event.GroupVersion().WithKind("Event"),
+				listAction.GetNamespace(),
+			)
+			if err != nil {
+				return true, nil, err
+			}
+
+			list, ok := obj.(*unstructured.UnstructuredList)
+			if !ok {
+				return true, nil, fmt.Errorf("unexpected list type %T", obj)
+			}
+
+			selector := listAction.GetListRestrictions().Fields
+			items := list.Items[:0]
+
+			for _, event := range list.Items {
+				uid, _, _ := unstructured.NestedString(event.Object, "involvedObject", "uid")
+				if selector.Matches(fields.Set{"involvedObject.uid": uid}) {
+					items = append(items, event)
+				}
+			}
+
+			list.Items = items
+
+			return true, list, nil
+		},
+	)
+}
+
+func TestDescribe(t *testing.T) {
+	component := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": clientfake.ComponentsAPIVersion,
+			"kind":       testKserveKind,
+			"metadata": map[string]any{
+				"name": testKserveInstanceName,
+				"uid":  testComponentUID,
+			},
+		},
+	}
+
+	c, dynamicClient := clientfake.NewClient(
+		clientfake.WithObjects(
+			newDescribeDeployment(testDeploymentName, testDeploymentUID, testComponentUID),
+			newDescribeDeployment(testOtherDeployment, testOtherUID, testOtherUID),
+			newDescribePod(),
+			newDescribeEvent(testAppsNamespace, testPodEvent, testPodUID, testLaterEventTime),
+			newDescribeEvent(metav1.NamespaceDefault, testComponentEvent, testComponentUID, testEarlierEventTime),
+			newDescribeEvent(testAppsNamespace, testUnrelatedEvent, testOtherUID, testEarlierEventTime),
+		),
+	)
+	honorEventFieldSelectors(dynamicClient)
+
+	t.Run("should only include owned deployments", func(t *testing.T) {
+		g := NewWithT(t)
+
+		description, err := components.Describe(t.Context(), c, component)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(description.Deployments).Should(HaveLen(1))
+		g.Expect(description.Deployments[0].GetName()).Should(Equal(testDeploymentName))
+	})
+
+	t.Run("should include pods matched by the deployment selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		description, err := components.Describe(t.Context(), c, component)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(description.Pods).Should(HaveLen(1))
+		g.Expect(description.Pods[0].GetName()).Should(Equal(testPodName))
+	})
+
+	t.Run("should include related events sorted by time", func(t *testing.T) {
+		g := NewWithT(t)
+
+		description, err := components.Describe(t.Context(), c, component)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(description.Events).Should(HaveLen(2))
+		g.Expect(description.Events[0].GetName()).Should(Equal(testComponentEvent))
+		g.Expect(description.Events[1].GetName()).Should(Equal(testPodEvent))
+	})
+
+	t.Run("should list events by involved object UID in the namespace of the object", func(t *testing.T) {
+		g := NewWithT(t)
+
+		dynamicClient.ClearActions()
+
+		_, err := components.Describe(t.Context(), c, component, components.WithNamespace(testAppsNamespace))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		namespaces := map[string]string{}
+
+		for _, action := range dynamicClient.Actions() {
+			if action.GetResource() != resources.Event {
+				continue
+			}
+
+			if listAction, ok := action.(clienttesting.ListAction); ok {
+				namespaces[listAction.GetListRestrictions().Fields.String()] = action.GetNamespace()
+			}
+		}
+
+		g.Expect(namespaces).Should(Equal(map[string]string{
+			"involvedObject.uid=" + testComponentUID:  metav1.NamespaceDefault,
+			"involvedObject.uid=" + testDeploymentUID: testAppsNamespace,
+			"involvedObject.uid=" + testPodUID:        testAppsNamespace,
+		}))
+	})
+
+	t.Run("should only keep the most recent events", func(t *testing.T) {
+		g := NewWithT(t)
+
+		description, err := components.Describe(t.Context(), c, component, components.WithEventLimit(1))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(description.Events).Should(HaveLen(1))
+		g.Expect(description.Events[0].GetName()).Should(Equal(testPodEvent))
+	})
+
+	t.Run("should only include deployments in the given namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		scoped, err := components.Describe(t.Context(), c, component, components.WithNamespace(testOtherNamespace))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(scoped.Deployments).Should(BeEmpty())
+		g.Expect(scoped.Pods).Should(BeEmpty())
+		g.Expect(scoped.Events).Should(HaveLen(1))
+		g.Expect(scoped.Events[0].GetName()).Should(Equal(testComponentEvent))
+	})
+}
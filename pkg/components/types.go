@@ -0,0 +1,81 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ListErrorReason classifies why a component resource could not be listed.
+type ListErrorReason string
+
+const (
+	// ListErrorForbidden indicates the user is not allowed to list the resource.
+	ListErrorForbidden ListErrorReason = "Forbidden"
+	// ListErrorNotFound indicates the resource is no longer served by the API server.
+	ListErrorNotFound ListErrorReason = "NotFound"
+	// ListErrorTimeout indicates the request timed out.
+	ListErrorTimeout ListErrorReason = "Timeout"
+	// ListErrorUnknown is used for any other failure.
+	ListErrorUnknown ListErrorReason = "Unknown"
+)
+
+// ResourceError records a component resource that could not be listed.
+type ResourceError struct {
+	Resource string `json:"resource"`
+	// Component is the name of the component in the DataScienceCluster spec, see ComponentName.
+	Component string          `json:"component,omitempty"`
+	Reason    ListErrorReason `json:"reason"`
+	Message   string          `json:"message"`
+
+	err error
+}
+
+// NewResourceError creates a ResourceError for the given resource, classifying the cause.
+func NewResourceError(resource metav1.APIResource, err error) ResourceError {
+	reason := ListErrorUnknown
+
+	switch {
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		reason = ListErrorForbidden
+	case apierrors.IsNotFound(err):
+		reason = ListErrorNotFound
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		reason = ListErrorTimeout
+	}
+
+	return ResourceError{
+		Resource:  resource.Name,
+		Component: ComponentName(resource),
+		Reason:    reason,
+		Message:   err.Error(),
+		err:       err,
+	}
+}
+
+// Error implements the error interface.
+func (e ResourceError) Error() string {
+	return fmt.Sprintf("failed to list %s (%s): %s", e.Resource, e.Reason, e.Message)
+}
+
+// Unwrap returns the underlying error.
+func (e ResourceError) Unwrap() error {
+	return e.err
+}
+
+// ListResult holds the components that could be listed together with the
+// component resources that failed, so that callers can tell a missing
+// component apart from one that could not be read.
+type ListResult struct {
+	Items  []unstructured.Unstructured `json:"items"`
+	Errors []ResourceError             `json:"errors,omitempty"`
+}
+
+// IsPartial reports whether any component resource failed to be listed.
+func (r *ListResult) IsPartial() bool {
+	return len(r.Errors) > 0
+}
@@ -0,0 +1,43 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// WaitForReady polls the singleton instance of the given component resource type until
+// it reports the Ready condition as True or the context is done.
+func WaitForReady(
+	ctx context.Context,
+	client *client.Client,
+	resource metav1.APIResource,
+	interval time.Duration,
+) error {
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		component, err := GetComponentByResource(ctx, client, resource)
+		if err != nil {
+			// The operator creates the component object asynchronously, keep polling
+			// until it shows up unless the request itself is rejected.
+			if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+				return false, err
+			}
+
+			return false, nil
+		}
+
+		return conditions.IsTrue(component, conditions.TypeReady), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed waiting for %s to become ready: %w", resource.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// WatchEvent describes a change to a component observed by a Watcher.
+type WatchEvent struct {
+	Type   watch.EventType
+	Object *unstructured.Unstructured
+}
+
+// WatchHandler is invoked for every relevant component change.
+// Returning an error stops the watch.
+type WatchHandler func(event WatchEvent) error
+
+// Watcher tracks component resources and reports components that appear, disappear
+// or whose Ready condition status or message changes.
+type Watcher struct {
+	client           *client.Client
+	resources        []metav1.APIResource
+	resourceVersions map[schema.GroupVersionResource]string
+	tracked          map[string]trackedComponent
+}
+
+// trackedComponent is the last known object and readiness of a component.
+type trackedComponent struct {
+	object *unstructured.Unstructured
+	state  readyState
+}
+
+// readyState is the subset of a component status that is relevant for change detection.
+type readyState struct {
+	status  string
+	message string
+}
+
+// watchMessage is sent from the per-resource watch loops to the dispatch loop.
+// Either event is set, or snapshot holds the full result of a relist.
+type watchMessage struct {
+	resource schema.GroupVersionResource
+	event    *WatchEvent
+	snapshot []unstructured.Unstructured
+	err      error
+}
+
+// NewWatcher creates a Watcher for every resource in the components.platform.opendatahub.io group.
+func NewWatcher(client *client.Client, opts ...Option) (*Watcher, error) {
+	componentResources, err := discoverComponentResources(client, newOptions(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Watcher{
+		client:           client,
+		resources:        componentResources,
+		resourceVersions: make(map[schema.GroupVersionResource]string),
+		tracked:          make(map[string]trackedComponent),
+	}, nil
+}
+
+// List lists all components and records the resource versions the subsequent Watch starts from.
+// Like ListComponents, resources that cannot be listed are recorded in the Errors of the result
+// rather than failing the whole list; they are not watched.
+func (w *Watcher) List(ctx context.Context) (*ListResult, error) {
+	result := &ListResult{
+		Items: []unstructured.Unstructured{},
+	}
+
+	for _, resource := range w.resources {
+		gvr := ComponentGVR(resource)
+
+		list, err := w.client.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, fmt.Errorf("listing components interrupted: %w", ctxErr)
+			}
+
+			result.Errors = append(result.Errors, NewResourceError(resource, err))
+
+			continue
+		}
+
+		w.resourceVersions[gvr] = list.GetResourceVersion()
+
+		for i := range list.Items {
+			w.tracked[stateKey(gvr, &list.Items[i])] = trackedComponent{
+				object: &list.Items[i],
+				state:  readyStateOf(&list.Items[i]),
+			}
+		}
+
+		result.Items = append(result.Items, list.Items...)
+	}
+
+	return result, nil
+}
+
+// Watch streams component changes to the handler until the context is done, the handler
+// returns an error or a watch fails with an unrecoverable error. List must be called first,
+// only the resources it listed are watched.
+// Expired watches (410 Gone) are recovered by relisting the affected resource and
+// reporting the differences with the last known state.
+func (w *Watcher) Watch(ctx context.Context, handler WatchHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages := make(chan watchMessage)
+
+	for gvr, resourceVersion := range w.resourceVersions {
+		go w.watchResource(ctx, gvr, resourceVersion, messages)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-messages:
+			if msg.err != nil {
+				return msg.err
+			}
+
+			events := w.dispatch(msg)
+			for i := range events {
+				if err := handler(events[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// watchResource runs the watch loop for a single resource, resuming from the last seen resource
+// version when the server closes the watch and relisting when that version has expired.
+func (w *Watcher) watchResource(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	resourceVersion string,
+	messages chan<- watchMessage,
+) {
+	send := func(msg watchMessage) bool {
+		msg.resource = gvr
+
+		select {
+		case messages <- msg:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for ctx.Err() == nil {
+		rv, err := w.watchOnce(ctx, gvr, resourceVersion, send)
+
+		switch {
+		case err == nil:
+			resourceVersion = rv
+		case apierrors.IsResourceExpired(err) || apierrors.IsGone(err):
+			list, err := w.client.Dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				send(watchMessage{err: fmt.Errorf("failed to relist %s: %w", gvr.Resource, err)})
+
+				return
+			}
+
+			resourceVersion = list.GetResourceVersion()
+
+			if !send(watchMessage{snapshot: list.Items}) {
+				return
+			}
+		default:
+			if ctx.Err() == nil {
+				send(watchMessage{err: fmt.Errorf("failed to watch %s: %w", gvr.Resource, err)})
+			}
+
+			return
+		}
+	}
+}
+
+// watchOnce consumes a single watch stream and returns the last seen resource version
+// once the server closes it.
+func (w *Watcher) watchOnce(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	resourceVersion string,
+	send func(watchMessage) bool,
+) (string, error) {
+	watcher, err := w.client.Dynamic.Resource(gvr).Watch(ctx, metav1.ListOptions{
+		ResourceVersion:     resourceVersion,
+		AllowWatchBookmarks: true,
+	})
+	if err != nil {
+		return resourceVersion, err
+	}
+
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+
+			if event.Type == watch.Error {
+				return resourceVersion, apierrors.FromObject(event.Object)
+			}
+
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				return resourceVersion, errors.New("unexpected object type in watch event")
+			}
+
+			resourceVersion = obj.GetResourceVersion()
+
+			if event.Type == watch.Bookmark {
+				continue
+			}
+
+			if !send(watchMessage{event: &WatchEvent{Type: event.Type, Object: obj}}) {
+				return resourceVersion, nil
+			}
+		}
+	}
+}
+
+// dispatch updates the known state from a watch message and returns the events
+// that represent relevant changes.
+func (w *Watcher) dispatch(msg watchMessage) []WatchEvent {
+	if msg.event != nil {
+		if w.update(msg.resource, *msg.event) {
+			return []WatchEvent{*msg.event}
+		}
+
+		return nil
+	}
+
+	var result []WatchEvent
+
+	seen := make(map[string]bool, len(msg.snapshot))
+
+	for i := range msg.snapshot {
+		obj := &msg.snapshot[i]
+		key := stateKey(msg.resource, obj)
+		seen[key] = true
+
+		eventType := watch.Modified
+		if _, known := w.tracked[key]; !known {
+			eventType = watch.Added
+		}
+
+		event := WatchEvent{Type: eventType, Object: obj}
+		if w.update(msg.resource, event) {
+			result = append(result, event)
+		}
+	}
+
+	prefix := msg.resource.Resource + "/"
+
+	keys := make([]string, 0, len(w.tracked))
+	for key := range w.tracked {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) || seen[key] {
+			continue
+		}
+
+		result = append(result, WatchEvent{Type: watch.Deleted, Object: w.tracked[key].object})
+		delete(w.tracked, key)
+	}
+
+	return result
+}
+
+// update records the state carried by the event and reports whether it is a relevant change.
+func (w *Watcher) update(gvr schema.GroupVersionResource, event WatchEvent) bool {
+	key := stateKey(gvr, event.Object)
+
+	if event.Type == watch.Deleted {
+		delete(w.tracked, key)
+
+		return true
+	}
+
+	state := readyStateOf(event.Object)
+	previous, known := w.tracked[key]
+	w.tracked[key] = trackedComponent{
+		object: event.Object,
+		state:  state,
+	}
+
+	return !known || previous.state != state
+}
+
+func stateKey(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	return gvr.Resource + "/" + obj.GetName()
+}
+
+func readyStateOf(obj *unstructured.Unstructured) readyState {
+	condition, err := conditions.Find(obj, conditions.TypeReady)
+	if err != nil || condition == nil {
+		return readyState{status: string(metav1.ConditionUnknown)}
+	}
+
+	return readyState{
+		status:  string(condition.Status),
+		message: condition.Message,
+	}
+}
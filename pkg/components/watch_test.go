@@ -0,0 +1,187 @@
+package components_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for watching components.
+const (
+	testKserveResource = "kserves"
+	testReadyMessage   = "all good"
+	testFailedMessage  = "deployment failed"
+)
+
+func newKserve(status string, message string) *unstructured.Unstructured {
+	return clientfake.NewComponent(testKserveKind, clientfake.NewCondition("Ready", status, message))
+}
+
+func newComponentsClient(objects ...runtime.Object) (*client.Client, *dynamicfake.FakeDynamicClient) {
+	return clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithDiscovery(clientfake.ComponentsAPIVersion,
+			metav1.APIResource{Name: testKserveResource + "/status", Kind: testKserveKind},
+		),
+		clientfake.WithObjects(objects...),
+	)
+}
+
+func TestWatcher(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamicClient := newComponentsClient(newKserve("True", testReadyMessage))
+
+	watchers := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake()}
+	watchCalls := 0
+
+	var mu sync.Mutex
+
+	dynamicClient.PrependWatchReactor(testKserveResource,
+		func(action clienttesting.Action) (bool, watch.Interface, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			w := watchers[watchCalls]
+			watchCalls++
+
+			return true, w, nil
+		},
+	)
+
+	watcher, err := components.NewWatcher(c)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	result, err := watcher.List(t.Context())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.IsPartial()).Should(BeFalse())
+	g.Expect(result.Items).Should(HaveLen(1))
+
+	events := make(chan components.WatchEvent, 10)
+	done := make(chan error, 1)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go func() {
+		done <- watcher.Watch(ctx, func(event components.WatchEvent) error {
+			events <- event
+
+			return nil
+		})
+	}()
+
+	t.Run("should ignore changes that do not affect readiness", func(t *testing.T) {
+		g := NewWithT(t)
+
+		watchers[0].Modify(newKserve("True", testReadyMessage))
+		g.Consistently(events).ShouldNot(Receive())
+	})
+
+	t.Run("should report readiness changes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		watchers[0].Modify(newKserve("False", testFailedMessage))
+
+		var event components.WatchEvent
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).Should(Equal(watch.Modified))
+		g.Expect(event.Object.GetName()).Should(Equal(testKserveInstanceName))
+	})
+
+	t.Run("should relist and report deletions when the watch expires", func(t *testing.T) {
+		g := NewWithT(t)
+
+		err := dynamicClient.Tracker().Delete(
+			clientfake.ComponentGVR(testKserveResource),
+			"",
+			testKserveInstanceName,
+		)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		watchers[0].Error(&metav1.Status{
+			Status: metav1.StatusFailure,
+			Code:   http.StatusGone,
+			Reason: metav1.StatusReasonExpired,
+		})
+
+		var event components.WatchEvent
+		g.Eventually(events).Should(Receive(&event))
+		g.Expect(event.Type).Should(Equal(watch.Deleted))
+		g.Expect(event.Object.GetName()).Should(Equal(testKserveInstanceName))
+
+		g.Eventually(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return watchCalls
+		}).Should(Equal(2))
+	})
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+}
+
+func TestWatcherListErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamicClient := newComponentsClient(newKserve("True", testReadyMessage))
+	dynamicClient.PrependReactor("list", testKserveResource,
+		func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(
+				schema.GroupResource{Resource: testKserveResource}, "", errors.New("denied"),
+			)
+		},
+	)
+
+	watched := make(chan struct{}, 1)
+	dynamicClient.PrependWatchReactor(testKserveResource,
+		func(action clienttesting.Action) (bool, watch.Interface, error) {
+			watched <- struct{}{}
+
+			return true, watch.NewFake(), nil
+		},
+	)
+
+	watcher, err := components.NewWatcher(c)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	result, err := watcher.List(t.Context())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Items).Should(BeEmpty())
+	g.Expect(result.Errors).Should(HaveLen(1))
+	g.Expect(result.Errors[0].Resource).Should(Equal(testKserveResource))
+	g.Expect(result.Errors[0].Reason).Should(Equal(components.ListErrorForbidden))
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- watcher.Watch(ctx, func(components.WatchEvent) error {
+			return nil
+		})
+	}()
+
+	g.Consistently(watched).ShouldNot(Receive())
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+}
@@ -0,0 +1,48 @@
+package doctor
+
+import (
+	"context"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// Check is a single diagnostic test executed against the cluster.
+type Check interface {
+	// Name returns the human readable name of the check.
+	Name() string
+	// Category returns the category the check belongs to.
+	Category() Category
+	// Execute runs the check. Failures to inspect the cluster are reported
+	// as a Result rather than an error so that the remaining checks still run.
+	Execute(ctx context.Context, client *client.Client) Result
+}
+
+// CheckFunc is the function executed by a check created with NewCheck.
+type CheckFunc func(ctx context.Context, client *client.Client) Result
+
+// NewCheck returns a Check with the given name and category that executes fn.
+func NewCheck(name string, category Category, fn CheckFunc) Check {
+	return &funcCheck{
+		name:     name,
+		category: category,
+		fn:       fn,
+	}
+}
+
+type funcCheck struct {
+	name     string
+	category Category
+	fn       CheckFunc
+}
+
+func (c *funcCheck) Name() string {
+	return c.name
+}
+
+func (c *funcCheck) Category() Category {
+	return c.category
+}
+
+func (c *funcCheck) Execute(ctx context.Context, client *client.Client) Result {
+	return c.fn(ctx, client)
+}
@@ -0,0 +1,194 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// Category is the category of the component checks.
+const Category doctor.Category = "Components"
+
+// Checks returns the checks verifying the readiness and reconciliation of every deployed component.
+// The components are listed once per doctor run, by the first check executed, and the result
+// is shared by the others.
+func Checks(opts ...Option) []doctor.Check {
+	options := newOptions(opts...)
+
+	return []doctor.Check{
+		doctor.NewCheck("Readiness", Category, func(ctx context.Context, client *client.Client) doctor.Result {
+			return checkReadiness(sharedComponents(ctx, client))
+		}),
+		doctor.NewCheck("Reconciliation", Category, func(ctx context.Context, client *client.Client) doctor.Result {
+			return checkReconciliation(sharedComponents(ctx, client))
+		}),
+		doctor.NewCheck("Stale conditions", Category, func(ctx context.Context, client *client.Client) doctor.Result {
+			return checkStaleConditions(sharedComponents(ctx, client), options)
+		}),
+	}
+}
+
+// checkReadiness reports the components whose Ready condition is not True.
+func checkReadiness(components listedComponents) doctor.Result {
+	if components.err != nil {
+		return doctor.Error("%v", components.err)
+	}
+
+	items, partial := components.items, components.partial
+
+	var notReady []string
+	var unknown []string
+
+	for i := range items {
+		condition, err := conditions.Find(&items[i], conditions.TypeReady)
+		if err != nil {
+			return doctor.Error("failed to read conditions of %s: %v", items[i].GetKind(), err)
+		}
+
+		switch {
+		case condition == nil || condition.Status == metav1.ConditionUnknown:
+			unknown = append(unknown, items[i].GetKind())
+		case condition.Status == metav1.ConditionFalse:
+			notReady = append(notReady, fmt.Sprintf("%s: %s", items[i].GetKind(), condition.Message))
+		}
+	}
+
+	switch {
+	case len(notReady) > 0:
+		return doctor.Error("%s", strings.Join(notReady, "; "))
+	case len(unknown) > 0:
+		return doctor.Warning("readiness unknown for %s", strings.Join(unknown, ", "))
+	case partial != "":
+		return doctor.Warning("%s", partial)
+	}
+
+	return doctor.OK("%d component(s) ready", len(items))
+}
+
+// checkReconciliation reports the components whose latest spec has not been observed yet.
+func checkReconciliation(components listedComponents) doctor.Result {
+	if components.err != nil {
+		return doctor.Error("%v", components.err)
+	}
+
+	items, partial := components.items, components.partial
+
+	var lagging []string
+
+	for i := range items {
+		observed, found, err := unstructured.NestedInt64(items[i].Object, "status", "observedGeneration")
+		if err != nil {
+			return doctor.Error("failed to read status.observedGeneration of %s: %v", items[i].GetKind(), err)
+		}
+
+		// Components that do not report an observed generation cannot be checked
+		if !found {
+			continue
+		}
+
+		if generation := items[i].GetGeneration(); observed < generation {
+			lagging = append(lagging, fmt.Sprintf("%s: observed generation %d, current %d", items[i].GetKind(), observed, generation))
+		}
+	}
+
+	switch {
+	case len(lagging) > 0:
+		return doctor.Warning("%s", strings.Join(lagging, "; "))
+	case partial != "":
+		return doctor.Warning("%s", partial)
+	}
+
+	return doctor.OK("%d component(s) reconciled", len(items))
+}
+
+// checkStaleConditions reports the conditions that are not True and have not
+// transitioned for longer than the configured threshold. True conditions are not
+// considered: a component that stays ready or reconciled is expected not to transition,
+// only a condition stuck in False or Unknown reveals a problem.
+func checkStaleConditions(components listedComponents, options Options) doctor.Result {
+	if components.err != nil {
+		return doctor.Error("%v", components.err)
+	}
+
+	items, partial := components.items, components.partial
+
+	now := options.Now()
+
+	var stale []string
+
+	for i := range items {
+		componentConditions, err := conditions.List(&items[i])
+		if err != nil {
+			return doctor.Error("failed to read conditions of %s: %v", items[i].GetKind(), err)
+		}
+
+		for _, condition := range componentConditions {
+			if condition.Status == metav1.ConditionTrue || condition.LastTransitionTime.IsZero() {
+				continue
+			}
+
+			age := now.Sub(condition.LastTransitionTime.Time)
+			if age <= options.StaleThreshold {
+				continue
+			}
+
+			stale = append(stale, fmt.Sprintf("%s: %s=%s for %s", items[i].GetKind(), condition.Type, condition.Status, duration.HumanDuration(age)))
+		}
+	}
+
+	switch {
+	case len(stale) > 0:
+		return doctor.Warning("%s", strings.Join(stale, "; "))
+	case partial != "":
+		return doctor.Warning("%s", partial)
+	}
+
+	return doctor.OK("no condition stuck for more than %s", duration.HumanDuration(options.StaleThreshold))
+}
+
+// listedComponents is the result of listing the deployed components, shared by the checks.
+type listedComponents struct {
+	items []unstructured.Unstructured
+	// partial summarizes the component resources that could not be listed, so that
+	// checks can report them.
+	partial string
+	err     error
+}
+
+// listedComponentsKey is the key of the listed components shared by the checks of a run.
+type listedComponentsKey struct{}
+
+// sharedComponents returns the components listed by the current doctor run.
+func sharedComponents(ctx context.Context, client *client.Client) listedComponents {
+	return doctor.Shared(ctx, listedComponentsKey{}, func() listedComponents {
+		return listComponents(ctx, client)
+	})
+}
+
+// listComponents lists the deployed components.
+func listComponents(ctx context.Context, client *client.Client) listedComponents {
+	result, err := components.ListComponents(ctx, client)
+	if err != nil {
+		return listedComponents{err: fmt.Errorf("failed to list components: %w", err)}
+	}
+
+	if !result.IsPartial() {
+		return listedComponents{items: result.Items}
+	}
+
+	failed := make([]string, 0, len(result.Errors))
+	for _, resourceErr := range result.Errors {
+		failed = append(failed, resourceErr.Error())
+	}
+
+	return listedComponents{items: result.Items, partial: strings.Join(failed, "; ")}
+}
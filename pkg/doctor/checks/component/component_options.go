@@ -0,0 +1,53 @@
+package component
+
+import (
+	"time"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util"
+)
+
+// DefaultStaleThreshold is the default time after which a condition that is not True
+// and has not transitioned is reported as stale.
+const DefaultStaleThreshold = 15 * time.Minute
+
+// Options holds the configuration of the component checks.
+type Options struct {
+	// StaleThreshold is the time after which a condition that is not True
+	// and has not transitioned is reported as stale.
+	StaleThreshold time.Duration
+	// Now returns the current time, it is used to compute the age of conditions.
+	Now func() time.Time
+}
+
+// Option is a functional option for configuring the component checks.
+type Option = util.Option[Options]
+
+// WithStaleThreshold sets the time after which a condition that is not True is reported as stale.
+// Values lower than or equal to zero are ignored.
+func WithStaleThreshold(threshold time.Duration) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		if threshold > 0 {
+			o.StaleThreshold = threshold
+		}
+	})
+}
+
+// WithNow sets the function returning the current time.
+func WithNow(now func() time.Time) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.Now = now
+	})
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		StaleThreshold: DefaultStaleThreshold,
+		Now:            time.Now,
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(&options)
+	}
+
+	return options
+}
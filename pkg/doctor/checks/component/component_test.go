@@ -0,0 +1,225 @@
+package component_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/component"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the deployed components.
+const (
+	testKserveResource    = "kserves"
+	testKserveKind        = "Kserve"
+	testDashboardResource = "dashboards"
+	testDashboardKind     = "Dashboard"
+	testNotReadyMessage   = "kserve-controller-manager is not available"
+	testNow               = "2025-01-01T12:00:00Z"
+	testRecentTransition  = "2025-01-01T11:55:00Z"
+	testOldTransition     = "2025-01-01T10:00:00Z"
+	testReadinessCheck    = "Readiness"
+	testReconcileCheck    = "Reconciliation"
+	testStaleCheck        = "Stale conditions"
+	testThreshold         = 30 * time.Minute
+)
+
+func newComponent(kind string, ready string, message string, lastTransition string) *unstructured.Unstructured {
+	condition := clientfake.NewCondition("Ready", ready, message)
+	condition["lastTransitionTime"] = lastTransition
+
+	component := clientfake.NewComponent(kind, condition)
+	component.SetGeneration(2)
+	_ = unstructured.SetNestedField(component.Object, int64(2), "status", "observedGeneration")
+
+	return component
+}
+
+func newClient(objects ...runtime.Object) (*client.Client, *dynamicfake.FakeDynamicClient) {
+	return clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithComponent(testDashboardResource, testDashboardKind),
+		clientfake.WithObjects(objects...),
+	)
+}
+
+// runCheck runs the component checks and returns the result of the named one.
+func runCheck(t *testing.T, c *client.Client, name string) doctor.CheckResult {
+	t.Helper()
+
+	now, err := time.Parse(time.RFC3339, testNow)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := component.Checks(
+		component.WithStaleThreshold(testThreshold),
+		component.WithNow(func() time.Time { return now }),
+	)
+
+	results, err := doctor.NewRegistry(checks...).Run(t.Context(), c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, result := range results.Checks {
+		if result.Name == name {
+			return result
+		}
+	}
+
+	t.Fatalf("check %s not found", name)
+
+	return doctor.CheckResult{}
+}
+
+func TestReadinessCheck(t *testing.T) {
+	t.Run("should pass when all components are ready", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newClient(
+			newComponent(testKserveKind, "True", "", testOldTransition),
+			newComponent(testDashboardKind, "True", "", testOldTransition),
+		)
+
+		result := runCheck(t, c, testReadinessCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+	})
+
+	t.Run("should report components that are not ready with their message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newClient(
+			newComponent(testKserveKind, "False", testNotReadyMessage, testOldTransition),
+			newComponent(testDashboardKind, "True", "", testOldTransition),
+		)
+
+		result := runCheck(t, c, testReadinessCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(Equal(testKserveKind + ": " + testNotReadyMessage))
+	})
+
+	t.Run("should warn when some component resources cannot be listed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, dynamicClient := newClient(newComponent(testKserveKind, "True", "", testOldTransition))
+		dynamicClient.PrependReactor("list", testDashboardResource,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("boom")
+			},
+		)
+
+		result := runCheck(t, c, testReadinessCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusWarning))
+		g.Expect(result.Message).Should(ContainSubstring(testDashboardResource))
+	})
+}
+
+func TestReconciliationCheck(t *testing.T) {
+	t.Run("should pass when the latest generation is observed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newClient(newComponent(testKserveKind, "True", "", testOldTransition))
+
+		result := runCheck(t, c, testReconcileCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+	})
+
+	t.Run("should flag components lagging behind their generation", func(t *testing.T) {
+		g := NewWithT(t)
+
+		lagging := newComponent(testKserveKind, "True", "", testOldTransition)
+		lagging.SetGeneration(3)
+
+		c, _ := newClient(lagging)
+
+		result := runCheck(t, c, testReconcileCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusWarning))
+		g.Expect(result.Message).Should(ContainSubstring("observed generation 2, current 3"))
+	})
+}
+
+func TestStaleConditionsCheck(t *testing.T) {
+	t.Run("should ignore recent and True conditions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newClient(
+			newComponent(testKserveKind, "False", testNotReadyMessage, testRecentTransition),
+			newComponent(testDashboardKind, "True", "", testOldTransition),
+		)
+
+		result := runCheck(t, c, testStaleCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+	})
+
+	t.Run("should flag conditions stuck for longer than the threshold", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := newClient(newComponent(testKserveKind, "False", testNotReadyMessage, testOldTransition))
+
+		result := runCheck(t, c, testStaleCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusWarning))
+		g.Expect(result.Message).Should(Equal(testKserveKind + ": Ready=False for 120m"))
+	})
+}
+
+func TestChecksListComponentsOnce(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamicClient := newClient(newComponent(testKserveKind, "True", "", testOldTransition))
+
+	runCheck(t, c, testReadinessCheck)
+
+	lists := map[string]int{}
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == "list" {
+			lists[action.GetResource().Resource]++
+		}
+	}
+
+	g.Expect(lists).Should(Equal(map[string]int{
+		testKserveResource:    1,
+		testDashboardResource: 1,
+	}))
+}
+
+func TestChecksListComponentsOncePerRun(t *testing.T) {
+	g := NewWithT(t)
+
+	c, dynamicClient := newClient(newComponent(testKserveKind, "True", "", testOldTransition))
+
+	registry := doctor.NewRegistry(component.Checks()...)
+
+	first, err := registry.Run(t.Context(), c)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(first.Checks[0].Status).Should(Equal(doctor.StatusOK))
+
+	g.Expect(dynamicClient.Tracker().Update(
+		clientfake.ComponentGVR(testKserveResource),
+		newComponent(testKserveKind, "False", testNotReadyMessage, testOldTransition),
+		"",
+	)).Should(Succeed())
+
+	second, err := registry.Run(t.Context(), c)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(second.Checks[0].Status).Should(Equal(doctor.StatusError))
+
+	lists := 0
+	for _, action := range dynamicClient.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == testKserveResource {
+			lists++
+		}
+	}
+
+	g.Expect(lists).Should(Equal(2))
+}
@@ -0,0 +1,64 @@
+package operator
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+	discoverypkg "github.com/lburgazzoli/odh-cli/pkg/util/discovery"
+)
+
+// conditionEstablished is the CustomResourceDefinition condition set once its API is served.
+const conditionEstablished = "Established"
+
+// checkCustomResourceDefinitions verifies the CRDs the CLI relies on are established:
+// DataScienceCluster, DSCInitialization and every component kind.
+func checkCustomResourceDefinitions(ctx context.Context, client *client.Client) doctor.Result {
+	names := []string{
+		resources.DataScienceCluster.GroupResource().String(),
+		resources.DSCInitialization.GroupResource().String(),
+	}
+
+	componentResources, err := discoverypkg.GetPreferredGroupResources(client.Discovery, resources.ComponentsGroup, "")
+	if err != nil {
+		return doctor.Error("failed to discover component resources: %v", err)
+	}
+
+	for _, resource := range componentResources {
+		// Subresources share the CRD of their parent resource
+		if strings.Contains(resource.Name, "/") {
+			continue
+		}
+
+		// CustomResourceDefinitions are named <resource>.<group>
+		names = append(names, schema.GroupResource{Group: resources.ComponentsGroup, Resource: resource.Name}.String())
+	}
+
+	var problems []string
+
+	for _, name := range names {
+		crd, err := client.Dynamic.Resource(resources.CustomResourceDefinition).Get(ctx, name, metav1.GetOptions{})
+
+		switch {
+		case apierrors.IsNotFound(err):
+			problems = append(problems, name+" not found")
+		case err != nil:
+			return doctor.Error("failed to get CustomResourceDefinition %s: %v", name, err)
+		case !conditions.IsTrue(crd, conditionEstablished):
+			problems = append(problems, name+" not established")
+		}
+	}
+
+	if len(problems) > 0 {
+		return doctor.Error("%s", strings.Join(problems, ", "))
+	}
+
+	return doctor.OK("%d CustomResourceDefinitions established", len(names))
+}
@@ -0,0 +1,51 @@
+package operator
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	operatorpkg "github.com/lburgazzoli/odh-cli/pkg/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// csvPhaseSucceeded is the phase of a ClusterServiceVersion whose install completed.
+const csvPhaseSucceeded = "Succeeded"
+
+// checkClusterServiceVersion verifies the ClusterServiceVersion of the operator reached the Succeeded phase.
+func checkClusterServiceVersion(ctx context.Context, client *client.Client) doctor.Result {
+	list, err := client.Dynamic.Resource(resources.ClusterServiceVersion).
+		Namespace(metav1.NamespaceAll).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return doctor.Error("failed to list ClusterServiceVersions: %v", err)
+	}
+
+	for i := range list.Items {
+		csv := &list.Items[i]
+
+		if !operatorpkg.IsClusterServiceVersion(csv) {
+			continue
+		}
+
+		name := csv.GetNamespace() + "/" + csv.GetName()
+		phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+
+		if phase != csvPhaseSucceeded {
+			message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+			if phase == "" {
+				phase = "Unknown"
+			}
+
+			return doctor.Error("ClusterServiceVersion %s is in phase %s: %s", name, phase, message)
+		}
+
+		return doctor.OK("ClusterServiceVersion %s succeeded", name)
+	}
+
+	return doctor.Error("no ClusterServiceVersion found for %s", strings.Join(operatorpkg.PackageNames, " or "))
+}
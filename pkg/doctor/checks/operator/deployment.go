@@ -0,0 +1,63 @@
+package operator
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	operatorpkg "github.com/lburgazzoli/odh-cli/pkg/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// conditionAvailable is the Deployment condition reporting minimum availability.
+const conditionAvailable = "Available"
+
+// checkDeployment verifies the operator Deployment is available. The Deployment is looked up
+// by name in the operator namespace, i.e. the namespace of the operator Subscription.
+func checkDeployment(ctx context.Context, client *client.Client) doctor.Result {
+	subscription, err := findSubscription(ctx, client)
+	if err != nil {
+		return doctor.Error("%v", err)
+	}
+
+	namespace := subscription.GetNamespace()
+
+	for _, deploymentName := range operatorpkg.DeploymentNames {
+		deployment, err := client.Dynamic.Resource(resources.Deployment).
+			Namespace(namespace).
+			Get(ctx, deploymentName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+
+		name := namespace + "/" + deploymentName
+
+		if err != nil {
+			return doctor.Error("failed to get Deployment %s: %v", name, err)
+		}
+
+		available, err := conditions.Find(deployment, conditionAvailable)
+		if err != nil {
+			return doctor.Error("failed to read conditions of Deployment %s: %v", name, err)
+		}
+
+		if available == nil || available.Status != metav1.ConditionTrue {
+			message := "no Available condition reported"
+			if available != nil {
+				message = available.Message
+			}
+
+			return doctor.Error("Deployment %s is not available: %s", name, message)
+		}
+
+		return doctor.OK("Deployment %s is available", name)
+	}
+
+	return doctor.Error("no operator Deployment found in namespace %s (looked for %s)",
+		namespace, strings.Join(operatorpkg.DeploymentNames, ", "))
+}
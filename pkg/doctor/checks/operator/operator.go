@@ -0,0 +1,18 @@
+package operator
+
+import (
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+)
+
+// Category is the category of the operator installation checks.
+const Category doctor.Category = "Operator"
+
+// Checks returns the checks verifying the operator installation.
+func Checks() []doctor.Check {
+	return []doctor.Check{
+		doctor.NewCheck("ClusterServiceVersion", Category, checkClusterServiceVersion),
+		doctor.NewCheck("Subscription", Category, checkSubscription),
+		doctor.NewCheck("Deployment", Category, checkDeployment),
+		doctor.NewCheck("CustomResourceDefinitions", Category, checkCustomResourceDefinitions),
+	}
+}
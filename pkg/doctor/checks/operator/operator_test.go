@@ -0,0 +1,225 @@
+package operator_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the operator installation.
+const (
+	testOperatorNamespace  = "openshift-operators"
+	testOtherNamespace     = "opendatahub"
+	testPackageName        = "opendatahub-operator"
+	testCSVName            = "opendatahub-operator.v2.30.0"
+	testOtherCSVName       = "authorino-operator.v1.0.0"
+	testSubscriptionName   = "opendatahub-operator"
+	testInstallPlanName    = "install-abcde"
+	testDeploymentName     = "opendatahub-operator-controller-manager"
+	testKserveResource     = "kserves"
+	testKserveCRD          = "kserves.components.platform.opendatahub.io"
+	testDSCCRD             = "datascienceclusters.datasciencecluster.opendatahub.io"
+	testDSCICRD            = "dscinitializations.dscinitialization.opendatahub.io"
+	testCSVSucceeded       = "Succeeded"
+	testCSVFailed          = "Failed"
+	testCSVFailedMessage   = "install strategy failed"
+	testStateAtLatest      = "AtLatestKnown"
+	testStateUpgradePend   = "UpgradePending"
+	testUnavailableMessage = "Deployment does not have minimum availability."
+	testCSVCheck           = "ClusterServiceVersion"
+	testSubscriptionCheck  = "Subscription"
+	testDeploymentCheck    = "Deployment"
+	testCRDCheck           = "CustomResourceDefinitions"
+)
+
+func newSubscription(state string) *unstructured.Unstructured {
+	subscription := clientfake.NewObject("operators.coreos.com/v1alpha1", "Subscription", testOperatorNamespace, testSubscriptionName)
+	subscription.Object["spec"] = map[string]any{
+		"name": testPackageName,
+	}
+	subscription.Object["status"] = map[string]any{
+		"state":          state,
+		"installedCSV":   testCSVName,
+		"installPlanRef": map[string]any{"name": testInstallPlanName},
+	}
+
+	return subscription
+}
+
+func newClient(objects ...runtime.Object) *client.Client {
+	c, _ := clientfake.NewClient(
+		clientfake.WithDiscovery(clientfake.ComponentsAPIVersion,
+			metav1.APIResource{Name: testKserveResource, Kind: "Kserve"},
+			metav1.APIResource{Name: testKserveResource + "/status", Kind: "Kserve"},
+		),
+		clientfake.WithObjects(objects...),
+	)
+
+	return c
+}
+
+// runCheck runs the operator checks and returns the result of the named one.
+func runCheck(t *testing.T, c *client.Client, name string) doctor.CheckResult {
+	t.Helper()
+
+	results, err := doctor.NewRegistry(operator.Checks()...).Run(t.Context(), c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, result := range results.Checks {
+		if result.Name == name {
+			return result
+		}
+	}
+
+	t.Fatalf("check %s not found", name)
+
+	return doctor.CheckResult{}
+}
+
+func TestClusterServiceVersionCheck(t *testing.T) {
+	t.Run("should pass when the CSV succeeded", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			clientfake.NewCSV(testOperatorNamespace, testOtherCSVName, testCSVFailed, ""),
+			clientfake.NewCSV(testOperatorNamespace, testCSVName, testCSVSucceeded, ""),
+		)
+
+		result := runCheck(t, c, testCSVCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+		g.Expect(result.Message).Should(ContainSubstring(testCSVName))
+	})
+
+	t.Run("should fail when the CSV did not succeed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(clientfake.NewCSV(testOperatorNamespace, testCSVName, testCSVFailed, testCSVFailedMessage))
+
+		result := runCheck(t, c, testCSVCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(ContainSubstring(testCSVFailedMessage))
+	})
+
+	t.Run("should ignore copied CSVs", func(t *testing.T) {
+		g := NewWithT(t)
+
+		copied := clientfake.NewCSV(testOtherNamespace, testCSVName, testCSVSucceeded, "")
+		copied.SetLabels(map[string]string{"olm.copiedFrom": testOperatorNamespace})
+
+		result := runCheck(t, newClient(copied), testCSVCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(ContainSubstring("no ClusterServiceVersion found"))
+	})
+}
+
+func TestSubscriptionCheck(t *testing.T) {
+	t.Run("should pass when the subscription is at the latest version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result := runCheck(t, newClient(newSubscription(testStateAtLatest)), testSubscriptionCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+	})
+
+	t.Run("should warn when an install plan is pending", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result := runCheck(t, newClient(newSubscription(testStateUpgradePend)), testSubscriptionCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusWarning))
+		g.Expect(result.Message).Should(ContainSubstring(testInstallPlanName))
+	})
+
+	t.Run("should fail when there is no subscription", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result := runCheck(t, newClient(), testSubscriptionCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+	})
+}
+
+func TestDeploymentCheck(t *testing.T) {
+	t.Run("should pass when the deployment is available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			newSubscription(testStateAtLatest),
+			clientfake.NewDeployment(testOperatorNamespace, testDeploymentName, "True", ""),
+		)
+
+		result := runCheck(t, c, testDeploymentCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+	})
+
+	t.Run("should fail when the deployment is not available", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			newSubscription(testStateAtLatest),
+			clientfake.NewDeployment(testOperatorNamespace, testDeploymentName, "False", testUnavailableMessage),
+		)
+
+		result := runCheck(t, c, testDeploymentCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(ContainSubstring(testUnavailableMessage))
+	})
+
+	t.Run("should ignore deployments outside of the operator namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			newSubscription(testStateAtLatest),
+			clientfake.NewDeployment(testOtherNamespace, testDeploymentName, "True", ""),
+		)
+
+		result := runCheck(t, c, testDeploymentCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(ContainSubstring(testOperatorNamespace))
+	})
+
+	t.Run("should fail when there is no subscription", func(t *testing.T) {
+		g := NewWithT(t)
+
+		result := runCheck(t, newClient(clientfake.NewDeployment(testOperatorNamespace, testDeploymentName, "True", "")), testDeploymentCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+	})
+}
+
+func TestCustomResourceDefinitionsCheck(t *testing.T) {
+	t.Run("should pass when all CRDs are established", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			clientfake.NewCRD(testDSCCRD, "True"),
+			clientfake.NewCRD(testDSCICRD, "True"),
+			clientfake.NewCRD(testKserveCRD, "True"),
+		)
+
+		result := runCheck(t, c, testCRDCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusOK))
+		g.Expect(result.Message).Should(HavePrefix("3 "))
+	})
+
+	t.Run("should report missing and not established CRDs", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient(
+			clientfake.NewCRD(testDSCCRD, "True"),
+			clientfake.NewCRD(testKserveCRD, "False"),
+		)
+
+		result := runCheck(t, c, testCRDCheck)
+		g.Expect(result.Status).Should(Equal(doctor.StatusError))
+		g.Expect(result.Message).Should(ContainSubstring(testDSCICRD + " not found"))
+		g.Expect(result.Message).Should(ContainSubstring(testKserveCRD + " not established"))
+	})
+}
@@ -0,0 +1,75 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	operatorpkg "github.com/lburgazzoli/odh-cli/pkg/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// OLM subscription states relevant to the checks.
+const (
+	subscriptionStateUpgradePending = "UpgradePending"
+	subscriptionStateUpgradeFailed  = "UpgradeFailed"
+)
+
+// conditionInstallPlanPending is the Subscription condition set while an install plan waits to be applied.
+const conditionInstallPlanPending = "InstallPlanPending"
+
+// checkSubscription verifies the operator Subscription has no install plan waiting to be applied.
+func checkSubscription(ctx context.Context, client *client.Client) doctor.Result {
+	subscription, err := findSubscription(ctx, client)
+	if err != nil {
+		return doctor.Error("%v", err)
+	}
+
+	name := subscription.GetNamespace() + "/" + subscription.GetName()
+	state, _, _ := unstructured.NestedString(subscription.Object, "status", "state")
+	installPlan, _, _ := unstructured.NestedString(subscription.Object, "status", "installPlanRef", "name")
+
+	switch {
+	case state == subscriptionStateUpgradeFailed:
+		return doctor.Error("Subscription %s failed to install plan %s", name, installPlan)
+	case state == subscriptionStateUpgradePending:
+		return doctor.Warning("Subscription %s has install plan %s pending", name, installPlan)
+	}
+
+	pending, err := conditions.Find(subscription, conditionInstallPlanPending)
+	if err != nil {
+		return doctor.Error("failed to read conditions of Subscription %s: %v", name, err)
+	}
+
+	if pending != nil && pending.Status == metav1.ConditionTrue {
+		return doctor.Warning("Subscription %s has install plan %s pending: %s", name, installPlan, pending.Reason)
+	}
+
+	installed, _, _ := unstructured.NestedString(subscription.Object, "status", "installedCSV")
+
+	return doctor.OK("Subscription %s installed %s", name, installed)
+}
+
+// findSubscription returns the Subscription of the ODH/RHOAI operator, looked up in all namespaces.
+func findSubscription(ctx context.Context, client *client.Client) (*unstructured.Unstructured, error) {
+	list, err := client.Dynamic.Resource(resources.Subscription).
+		Namespace(metav1.NamespaceAll).
+		List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	for i := range list.Items {
+		if operatorpkg.IsSubscription(&list.Items[i]) {
+			return &list.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no Subscription found for %s", strings.Join(operatorpkg.PackageNames, " or "))
+}
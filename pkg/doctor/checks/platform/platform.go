@@ -0,0 +1,63 @@
+package platform
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// Category is the category of the platform checks.
+const Category doctor.Category = "Platform"
+
+// phaseReady is the phase reported by the DSCInitialization and the
+// DataScienceCluster once they have been fully reconciled.
+const phaseReady = "Ready"
+
+// Checks returns the checks verifying the platform singletons.
+func Checks() []doctor.Check {
+	return []doctor.Check{
+		doctor.NewCheck("DSCInitialization", Category, checkDSCInitialization),
+		doctor.NewCheck("DataScienceCluster", Category, checkDataScienceCluster),
+	}
+}
+
+func checkDSCInitialization(ctx context.Context, client *client.Client) doctor.Result {
+	initialization, err := dsci.GetDSCInitialization(ctx, client.Dynamic)
+	if err != nil {
+		return doctor.Error("%v", err)
+	}
+
+	return checkPhase(initialization)
+}
+
+func checkDataScienceCluster(ctx context.Context, client *client.Client) doctor.Result {
+	cluster, err := dsc.GetDataScienceCluster(ctx, client.Dynamic)
+	if err != nil {
+		return doctor.Error("%v", err)
+	}
+
+	return checkPhase(cluster)
+}
+
+// checkPhase reports a warning unless the object reached the Ready phase.
+func checkPhase(obj *unstructured.Unstructured) doctor.Result {
+	phase, _, err := unstructured.NestedString(obj.Object, "status", "phase")
+	if err != nil {
+		return doctor.Error("failed to read status.phase of %s: %v", obj.GetName(), err)
+	}
+
+	if phase != phaseReady {
+		if phase == "" {
+			phase = "Unknown"
+		}
+
+		return doctor.Warning("%s %s is in phase %s", obj.GetKind(), obj.GetName(), phase)
+	}
+
+	return doctor.OK("%s %s is ready", obj.GetKind(), obj.GetName())
+}
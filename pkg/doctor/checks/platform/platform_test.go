@@ -0,0 +1,76 @@
+package platform_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/doctor/checks/platform"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the platform singletons.
+const (
+	testDSCIAPIVersion = "dscinitialization.opendatahub.io/v1"
+	testDSCIKind       = "DSCInitialization"
+	testDSCIName       = "default-dsci"
+	testDSCAPIVersion  = "datasciencecluster.opendatahub.io/v1"
+	testDSCKind        = "DataScienceCluster"
+	testDSCName        = "default-dsc"
+	testPhaseReady     = "Ready"
+	testPhaseProgress  = "Progressing"
+)
+
+func newSingleton(apiVersion string, kind string, name string, phase string) *unstructured.Unstructured {
+	obj := clientfake.NewObject(apiVersion, kind, "", name)
+	obj.Object["status"] = map[string]any{
+		"phase": phase,
+	}
+
+	return obj
+}
+
+func TestChecks(t *testing.T) {
+	t.Run("should pass when the singletons are ready", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := clientfake.NewClient(clientfake.WithObjects(
+			newSingleton(testDSCIAPIVersion, testDSCIKind, testDSCIName, testPhaseReady),
+			newSingleton(testDSCAPIVersion, testDSCKind, testDSCName, testPhaseReady),
+		))
+
+		results, err := doctor.NewRegistry(platform.Checks()...).Run(t.Context(), c)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Summary).Should(Equal(doctor.Summary{OK: 2}))
+	})
+
+	t.Run("should warn when a singleton is not ready", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := clientfake.NewClient(clientfake.WithObjects(
+			newSingleton(testDSCIAPIVersion, testDSCIKind, testDSCIName, testPhaseReady),
+			newSingleton(testDSCAPIVersion, testDSCKind, testDSCName, testPhaseProgress),
+		))
+
+		results, err := doctor.NewRegistry(platform.Checks()...).Run(t.Context(), c)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Checks[1].Status).Should(Equal(doctor.StatusWarning))
+		g.Expect(results.Checks[1].Message).Should(ContainSubstring(testPhaseProgress))
+	})
+
+	t.Run("should fail when a singleton is missing", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c, _ := clientfake.NewClient(clientfake.WithObjects(
+			newSingleton(testDSCIAPIVersion, testDSCIKind, testDSCIName, testPhaseReady),
+		))
+
+		results, err := doctor.NewRegistry(platform.Checks()...).Run(t.Context(), c)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Checks[1].Status).Should(Equal(doctor.StatusError))
+		g.Expect(results.HasErrors()).Should(BeTrue())
+	})
+}
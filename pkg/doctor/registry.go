@@ -0,0 +1,95 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// Registry holds the checks executed by the doctor command.
+// Categories are executed in the order they were first registered, and the
+// checks of a category in the order they were registered.
+type Registry struct {
+	categories []Category
+	checks     map[Category][]Check
+}
+
+// NewRegistry returns a Registry with the given checks registered.
+func NewRegistry(checks ...Check) *Registry {
+	r := &Registry{
+		checks: make(map[Category][]Check),
+	}
+
+	r.Register(checks...)
+
+	return r
+}
+
+// Register adds checks to the registry.
+func (r *Registry) Register(checks ...Check) {
+	for _, check := range checks {
+		category := check.Category()
+
+		if _, ok := r.checks[category]; !ok {
+			r.categories = append(r.categories, category)
+		}
+
+		r.checks[category] = append(r.checks[category], check)
+	}
+}
+
+// Categories returns the registered categories in execution order.
+func (r *Registry) Categories() []Category {
+	return slices.Clone(r.categories)
+}
+
+// Checks returns the checks of the given category in execution order.
+func (r *Registry) Checks(category Category) []Check {
+	return slices.Clone(r.checks[category])
+}
+
+// Run executes the registered checks category by category and collects their results.
+// Only the selected categories are executed when categories are given. Values the checks
+// share with Shared are kept for the duration of the run only.
+func (r *Registry) Run(
+	ctx context.Context,
+	client *client.Client,
+	categories ...Category,
+) (*CheckResults, error) {
+	for _, category := range categories {
+		if _, ok := r.checks[category]; !ok {
+			return nil, fmt.Errorf("unknown check category %q", category)
+		}
+	}
+
+	results := &CheckResults{
+		Checks: []CheckResult{},
+	}
+
+	ctx = withRunState(ctx)
+
+	for _, category := range r.categories {
+		if len(categories) > 0 && !slices.Contains(categories, category) {
+			continue
+		}
+
+		for _, check := range r.checks[category] {
+			if err := ctx.Err(); err != nil {
+				return nil, fmt.Errorf("doctor run interrupted: %w", err)
+			}
+
+			result := check.Execute(ctx, client)
+
+			results.add(CheckResult{
+				Name:     check.Name(),
+				Category: category,
+				Status:   result.Status,
+				Message:  result.Message,
+			})
+		}
+	}
+
+	return results, nil
+}
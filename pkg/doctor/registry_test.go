@@ -0,0 +1,126 @@
+package doctor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/doctor"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for check execution.
+const (
+	testOperatorCategory   doctor.Category = "Operator"
+	testComponentsCategory doctor.Category = "Components"
+	testUnknownCategory    doctor.Category = "Unknown"
+	testCSVCheck                           = "CSV"
+	testSubscriptionCheck                  = "Subscription"
+	testKserveCheck                        = "Kserve"
+	testOKMessage                          = "all good"
+	testWarningMessage                     = "not ready yet"
+	testErrorMessage                       = "broken"
+)
+
+func newStaticCheck(name string, category doctor.Category, result doctor.Result) doctor.Check {
+	return doctor.NewCheck(name, category, func(context.Context, *client.Client) doctor.Result {
+		return result
+	})
+}
+
+func newTestRegistry() *doctor.Registry {
+	return doctor.NewRegistry(
+		newStaticCheck(testKserveCheck, testComponentsCategory, doctor.Warning(testWarningMessage)),
+		newStaticCheck(testCSVCheck, testOperatorCategory, doctor.OK(testOKMessage)),
+		newStaticCheck(testSubscriptionCheck, testOperatorCategory, doctor.Error(testErrorMessage)),
+	)
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("should group checks by category in registration order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := newTestRegistry()
+
+		g.Expect(registry.Categories()).Should(Equal([]doctor.Category{testComponentsCategory, testOperatorCategory}))
+		g.Expect(registry.Checks(testOperatorCategory)).Should(HaveLen(2))
+	})
+
+	t.Run("should run all checks and summarize the results", func(t *testing.T) {
+		g := NewWithT(t)
+
+		results, err := newTestRegistry().Run(t.Context(), &client.Client{})
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(results.Checks).Should(Equal([]doctor.CheckResult{
+			{Name: testKserveCheck, Category: testComponentsCategory, Status: doctor.StatusWarning, Message: testWarningMessage},
+			{Name: testCSVCheck, Category: testOperatorCategory, Status: doctor.StatusOK, Message: testOKMessage},
+			{Name: testSubscriptionCheck, Category: testOperatorCategory, Status: doctor.StatusError, Message: testErrorMessage},
+		}))
+		g.Expect(results.Summary).Should(Equal(doctor.Summary{OK: 1, Warning: 1, Error: 1}))
+		g.Expect(results.HasErrors()).Should(BeTrue())
+	})
+
+	t.Run("should only run the selected categories", func(t *testing.T) {
+		g := NewWithT(t)
+
+		results, err := newTestRegistry().Run(t.Context(), &client.Client{}, testComponentsCategory)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Checks).Should(HaveLen(1))
+		g.Expect(results.Checks[0].Name).Should(Equal(testKserveCheck))
+		g.Expect(results.HasErrors()).Should(BeFalse())
+	})
+
+	t.Run("should reject unknown categories", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := newTestRegistry().Run(t.Context(), &client.Client{}, testUnknownCategory)
+		g.Expect(err).Should(MatchError(ContainSubstring("unknown check category")))
+	})
+}
+
+func TestShared(t *testing.T) {
+	type sharedKey struct{}
+
+	calls := 0
+	compute := func() int {
+		calls++
+
+		return calls
+	}
+
+	check := doctor.NewCheck(testKserveCheck, testComponentsCategory, func(ctx context.Context, _ *client.Client) doctor.Result {
+		return doctor.OK("%d", doctor.Shared(ctx, sharedKey{}, compute))
+	})
+	other := doctor.NewCheck(testCSVCheck, testOperatorCategory, func(ctx context.Context, _ *client.Client) doctor.Result {
+		return doctor.OK("%d", doctor.Shared(ctx, sharedKey{}, compute))
+	})
+
+	registry := doctor.NewRegistry(check, other)
+
+	t.Run("should compute the value once per run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		results, err := registry.Run(t.Context(), &client.Client{})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Checks[0].Message).Should(Equal("1"))
+		g.Expect(results.Checks[1].Message).Should(Equal("1"))
+	})
+
+	t.Run("should compute the value again in the next run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		results, err := registry.Run(t.Context(), &client.Client{})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(results.Checks[0].Message).Should(Equal("2"))
+		g.Expect(results.Checks[1].Message).Should(Equal("2"))
+	})
+
+	t.Run("should compute the value on each call outside of a run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(doctor.Shared(t.Context(), sharedKey{}, compute)).Should(Equal(3))
+		g.Expect(doctor.Shared(t.Context(), sharedKey{}, compute)).Should(Equal(4))
+	})
+}
@@ -0,0 +1,40 @@
+package doctor
+
+import (
+	"context"
+	"sync"
+)
+
+type runStateKey struct{}
+
+// runState holds the values shared by the checks of a single Registry.Run.
+type runState struct {
+	mu     sync.Mutex
+	values map[any]any
+}
+
+func withRunState(ctx context.Context) context.Context {
+	return context.WithValue(ctx, runStateKey{}, &runState{values: make(map[any]any)})
+}
+
+// Shared returns the value stored under key by a previous check of the current run, or
+// computes it with fn and stores it for the following ones, so that checks inspecting the
+// same resources fetch them once per run. Outside of a run, fn is called every time.
+func Shared[T any](ctx context.Context, key any, fn func() T) T {
+	state, ok := ctx.Value(runStateKey{}).(*runState)
+	if !ok {
+		return fn()
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if value, ok := state.values[key].(T); ok {
+		return value
+	}
+
+	value := fn()
+	state.values[key] = value
+
+	return value
+}
@@ -0,0 +1,85 @@
+package doctor
+
+import "fmt"
+
+// Status is the outcome of a diagnostic check.
+type Status string
+
+const (
+	// StatusOK means the check found no issue.
+	StatusOK Status = "OK"
+	// StatusWarning means the check found an issue that does not prevent the platform from working.
+	StatusWarning Status = "WARNING"
+	// StatusError means the check found an issue that needs to be addressed.
+	StatusError Status = "ERROR"
+)
+
+// Category groups related checks, checks are executed category by category.
+type Category string
+
+// Result is the outcome of a single check execution.
+type Result struct {
+	Status  Status
+	Message string
+}
+
+// OK returns a successful Result with the given message.
+func OK(format string, args ...any) Result {
+	return newResult(StatusOK, format, args...)
+}
+
+// Warning returns a Result reporting a non-blocking issue.
+func Warning(format string, args ...any) Result {
+	return newResult(StatusWarning, format, args...)
+}
+
+// Error returns a Result reporting an issue that needs to be addressed.
+func Error(format string, args ...any) Result {
+	return newResult(StatusError, format, args...)
+}
+
+func newResult(status Status, format string, args ...any) Result {
+	return Result{
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// CheckResult is the Result of a check, together with the check identity.
+type CheckResult struct {
+	Name     string   `json:"name"`
+	Category Category `json:"category"`
+	Status   Status   `json:"status"`
+	Message  string   `json:"message"`
+}
+
+// Summary counts check results by status.
+type Summary struct {
+	OK      int `json:"ok"`
+	Warning int `json:"warning"`
+	Error   int `json:"error"`
+}
+
+// CheckResults holds the results of a doctor run in execution order.
+type CheckResults struct {
+	Checks  []CheckResult `json:"checks"`
+	Summary Summary       `json:"summary"`
+}
+
+// HasErrors reports whether any check ended with StatusError.
+func (r *CheckResults) HasErrors() bool {
+	return r.Summary.Error > 0
+}
+
+func (r *CheckResults) add(result CheckResult) {
+	r.Checks = append(r.Checks, result)
+
+	switch result.Status {
+	case StatusOK:
+		r.Summary.OK++
+	case StatusWarning:
+		r.Summary.Warning++
+	case StatusError:
+		r.Summary.Error++
+	}
+}
@@ -0,0 +1,129 @@
+package dsc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+)
+
+// FieldManager is the field manager used for the patch requests issued by the CLI.
+const FieldManager = "kubectl-odh"
+
+// ManagementState is the desired state of a component in the DataScienceCluster.
+type ManagementState string
+
+const (
+	// Managed instructs the operator to deploy and reconcile the component.
+	Managed ManagementState = "Managed"
+	// Removed instructs the operator to remove the component.
+	Removed ManagementState = "Removed"
+	// Unmanaged instructs the operator to leave the component resources untouched.
+	Unmanaged ManagementState = "Unmanaged"
+)
+
+// ParseManagementState parses a management state, ignoring case.
+func ParseManagementState(value string) (ManagementState, error) {
+	for _, state := range []ManagementState{Managed, Removed, Unmanaged} {
+		if strings.EqualFold(value, string(state)) {
+			return state, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid management state %q (must be %s, %s or %s)", value, Managed, Removed, Unmanaged)
+}
+
+// GetDataScienceCluster retrieves the singleton DataScienceCluster instance.
+func GetDataScienceCluster(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+) (*unstructured.Unstructured, error) {
+	list, err := dynamicClient.Resource(resources.DataScienceCluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DataScienceCluster: %w", err)
+	}
+
+	switch len(list.Items) {
+	case 0:
+		return nil, fmt.Errorf("no DataScienceCluster found")
+	case 1:
+		return &list.Items[0], nil
+	default:
+		return nil, fmt.Errorf("expected a single DataScienceCluster, found %d", len(list.Items))
+	}
+}
+
+// NewManagementStatePatch builds a patch for the given DataScienceCluster that only sets
+// spec.components.<name>.managementState for the given components.
+func NewManagementStatePatch(
+	dsc *unstructured.Unstructured,
+	states map[string]ManagementState,
+) *unstructured.Unstructured {
+	components := make(map[string]any, len(states))
+	for name, state := range states {
+		components[name] = map[string]any{
+			"managementState": string(state),
+		}
+	}
+
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": dsc.GetAPIVersion(),
+			"kind":       dsc.GetKind(),
+			"metadata": map[string]any{
+				"name": dsc.GetName(),
+			},
+			"spec": map[string]any{
+				"components": components,
+			},
+		},
+	}
+}
+
+// ApplyPatch sends the spec of a patch built by NewManagementStatePatch as a JSON merge patch,
+// so that only the management state of the given components is changed. A server-side apply
+// would instead drop the state of the components set by previous requests of the same field
+// manager and left out of this one. It returns the patched DataScienceCluster returned by the
+// server, which is not persisted with the server dry-run strategy. With the client dry-run strategy
+// the patch is not sent to the server and is returned as is.
+func ApplyPatch(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	patch *unstructured.Unstructured,
+	dryRun dryrun.Strategy,
+) (*unstructured.Unstructured, error) {
+	if dryRun == dryrun.Client {
+		return patch, nil
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"spec": patch.Object["spec"],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	patched, err := dynamicClient.Resource(resources.DataScienceCluster).Patch(
+		ctx,
+		patch.GetName(),
+		types.MergePatchType,
+		data,
+		metav1.PatchOptions{
+			FieldManager: FieldManager,
+			DryRun:       dryRun.Options(),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch DataScienceCluster %s: %w", patch.GetName(), err)
+	}
+
+	return patched, nil
+}
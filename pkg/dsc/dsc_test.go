@@ -0,0 +1,152 @@
+package dsc_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+	"github.com/lburgazzoli/odh-cli/pkg/util/dryrun"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the DataScienceCluster singleton.
+const (
+	testDSCAPIVersion  = "datasciencecluster.opendatahub.io/v1"
+	testDSCKind        = "DataScienceCluster"
+	testDSCName        = "default-dsc"
+	testComponent      = "kserve"
+	testOtherComponent = "dashboard"
+)
+
+func TestGetDataScienceCluster(t *testing.T) {
+	t.Run("should return the singleton instance", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName)))
+
+		result, err := dsc.GetDataScienceCluster(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.GetName()).Should(Equal(testDSCName))
+	})
+
+	t.Run("should fail when no instance exists", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient()
+
+		_, err := dsc.GetDataScienceCluster(t.Context(), client)
+		g.Expect(err).Should(MatchError(ContainSubstring("no DataScienceCluster found")))
+	})
+
+	t.Run("should fail when multiple instances exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName), clientfake.NewDataScienceCluster(testDSCName+"-2")))
+
+		_, err := dsc.GetDataScienceCluster(t.Context(), client)
+		g.Expect(err).Should(MatchError(ContainSubstring("found 2")))
+	})
+}
+
+func TestNewManagementStatePatch(t *testing.T) {
+	g := NewWithT(t)
+
+	patch := dsc.NewManagementStatePatch(clientfake.NewDataScienceCluster(testDSCName), map[string]dsc.ManagementState{
+		testComponent: dsc.Managed,
+	})
+
+	g.Expect(patch.GetAPIVersion()).Should(Equal(testDSCAPIVersion))
+	g.Expect(patch.GetKind()).Should(Equal(testDSCKind))
+	g.Expect(patch.GetName()).Should(Equal(testDSCName))
+
+	state, found, err := unstructured.NestedString(patch.Object, "spec", "components", testComponent, "managementState")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(found).Should(BeTrue())
+	g.Expect(state).Should(Equal(string(dsc.Managed)))
+}
+
+func TestApplyPatch(t *testing.T) {
+	patch := dsc.NewManagementStatePatch(clientfake.NewDataScienceCluster(testDSCName), map[string]dsc.ManagementState{
+		testComponent: dsc.Removed,
+	})
+
+	t.Run("should merge patch the management state", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName)))
+
+		_, err := dsc.ApplyPatch(t.Context(), client, patch, dryrun.None)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		actions := client.Actions()
+		g.Expect(actions).Should(HaveLen(1))
+
+		patchAction, ok := actions[0].(clienttesting.PatchAction)
+		g.Expect(ok).Should(BeTrue())
+		g.Expect(patchAction.GetPatchType()).Should(Equal(types.MergePatchType))
+		g.Expect(patchAction.GetName()).Should(Equal(testDSCName))
+		g.Expect(string(patchAction.GetPatch())).Should(Equal(`{"spec":{"components":{"kserve":{"managementState":"Removed"}}}}`))
+	})
+
+	t.Run("should keep the state of components patched before", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName)))
+
+		for _, component := range []string{testComponent, testOtherComponent} {
+			patch := dsc.NewManagementStatePatch(clientfake.NewDataScienceCluster(testDSCName), map[string]dsc.ManagementState{
+				component: dsc.Managed,
+			})
+
+			_, err := dsc.ApplyPatch(t.Context(), client, patch, dryrun.None)
+			g.Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		result, err := dsc.GetDataScienceCluster(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		for _, component := range []string{testComponent, testOtherComponent} {
+			state, _, err := unstructured.NestedString(result.Object, "spec", "components", component, "managementState")
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(state).Should(Equal(string(dsc.Managed)), component)
+		}
+	})
+
+	t.Run("should not contact the server with client dry-run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(clientfake.NewDataScienceCluster(testDSCName)))
+
+		result, err := dsc.ApplyPatch(t.Context(), client, patch, dryrun.Client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result).Should(Equal(patch))
+		g.Expect(client.Actions()).Should(BeEmpty())
+	})
+
+	t.Run("should return the object returned by the server with server dry-run", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cluster := clientfake.NewDataScienceCluster(testDSCName)
+		cluster.Object["spec"] = map[string]any{
+			"components": map[string]any{
+				testOtherComponent: map[string]any{"managementState": string(dsc.Managed)},
+			},
+		}
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(cluster))
+
+		result, err := dsc.ApplyPatch(t.Context(), client, patch, dryrun.Server)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.Object).Should(HaveKeyWithValue("spec", map[string]any{
+			"components": map[string]any{
+				testComponent:      map[string]any{"managementState": string(dsc.Removed)},
+				testOtherComponent: map[string]any{"managementState": string(dsc.Managed)},
+			},
+		}))
+	})
+}
@@ -0,0 +1,139 @@
+package dsc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	"github.com/lburgazzoli/odh-cli/pkg/util/conditions"
+)
+
+// ComponentStatus compares the desired management state of a component in the
+// DataScienceCluster with the readiness observed on the matching component resource.
+type ComponentStatus struct {
+	Name            string          `json:"name"`
+	ManagementState ManagementState `json:"managementState"`
+	Deployed        bool            `json:"deployed"`
+	Ready           string          `json:"ready,omitempty"`
+	Message         string          `json:"message,omitempty"`
+	Drift           string          `json:"drift,omitempty"`
+}
+
+// StatusResult holds the per-component status together with the component
+// resources that could not be listed.
+type StatusResult struct {
+	Name       string                     `json:"name"`
+	Components []ComponentStatus          `json:"components"`
+	Errors     []components.ResourceError `json:"errors,omitempty"`
+}
+
+// GetComponentStatuses reads the desired state of every component from the DataScienceCluster
+// and matches it with the Ready condition of the corresponding component resource.
+func GetComponentStatuses(
+	ctx context.Context,
+	client *client.Client,
+	cluster *unstructured.Unstructured,
+	opts ...components.Option,
+) (*StatusResult, error) {
+	specComponents, _, err := unstructured.NestedMap(cluster.Object, "spec", "components")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec.components: %w", err)
+	}
+
+	componentList, err := components.ListComponents(ctx, client, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+
+	deployed := make(map[string]*unstructured.Unstructured, len(componentList.Items))
+	for i := range componentList.Items {
+		deployed[strings.ToLower(componentList.Items[i].GetKind())] = &componentList.Items[i]
+	}
+
+	failed := make(map[string]components.ResourceError, len(componentList.Errors))
+	for _, resourceErr := range componentList.Errors {
+		failed[resourceErr.Component] = resourceErr
+	}
+
+	result := &StatusResult{
+		Name:       cluster.GetName(),
+		Components: make([]ComponentStatus, 0, len(specComponents)),
+		Errors:     componentList.Errors,
+	}
+
+	for name, spec := range specComponents {
+		status := ComponentStatus{
+			Name: name,
+		}
+
+		if fields, ok := spec.(map[string]any); ok {
+			if state, ok := fields["managementState"].(string); ok {
+				status.ManagementState = ManagementState(state)
+			}
+		}
+
+		// Whether a component is deployed is not known when its resource could not be listed.
+		if resourceErr, ok := failed[name]; ok {
+			status.Ready = string(metav1.ConditionUnknown)
+			status.Message = resourceErr.Error()
+			status.Drift = driftUnknown
+			result.Components = append(result.Components, status)
+
+			continue
+		}
+
+		if component, ok := deployed[name]; ok {
+			status.Deployed = true
+			status.Ready = string(metav1.ConditionUnknown)
+
+			condition, err := conditions.Find(component, conditions.TypeReady)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read conditions of %s: %w", component.GetName(), err)
+			}
+
+			if condition != nil {
+				status.Ready = string(condition.Status)
+				status.Message = condition.Message
+			}
+		}
+
+		status.Drift = drift(status)
+		result.Components = append(result.Components, status)
+	}
+
+	sort.Slice(result.Components, func(i int, j int) bool {
+		return result.Components[i].Name < result.Components[j].Name
+	})
+
+	return result, nil
+}
+
+// driftUnknown is the drift of the components whose resource could not be listed.
+const driftUnknown = "Unknown"
+
+// drift describes the mismatch between desired and observed state, or returns an
+// empty string when they agree.
+func drift(status ComponentStatus) string {
+	switch status.ManagementState {
+	case Managed:
+		if !status.Deployed {
+			return "Managed but not deployed"
+		}
+
+		if status.Ready != string(metav1.ConditionTrue) {
+			return "Managed but not ready"
+		}
+	case Removed:
+		if status.Deployed {
+			return "Removed but still deployed"
+		}
+	}
+
+	return ""
+}
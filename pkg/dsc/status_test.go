@@ -0,0 +1,135 @@
+package dsc_test
+
+import (
+	"errors"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsc"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the component resources observed by the status check.
+const (
+	testKserveResource    = "kserves"
+	testKserveKind        = "Kserve"
+	testCodeFlareResource = "codeflares"
+	testCodeFlareKind     = "CodeFlare"
+	testDashboardResource = "dashboards"
+	testDashboardKind     = "Dashboard"
+)
+
+func newComponent(kind string, ready string) *unstructured.Unstructured {
+	return clientfake.NewComponent(kind, clientfake.NewCondition("Ready", ready, kind+" is "+ready))
+}
+
+func newStatusClient(objects ...runtime.Object) (*client.Client, *dynamicfake.FakeDynamicClient) {
+	return clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithComponent(testCodeFlareResource, testCodeFlareKind),
+		clientfake.WithComponent(testDashboardResource, testDashboardKind),
+		clientfake.WithObjects(objects...),
+	)
+}
+
+func TestGetComponentStatuses(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := clientfake.NewDataScienceCluster(testDSCName)
+	cluster.Object["spec"] = map[string]any{
+		"components": map[string]any{
+			"kserve":    map[string]any{"managementState": string(dsc.Managed)},
+			"codeflare": map[string]any{"managementState": string(dsc.Removed)},
+			"dashboard": map[string]any{"managementState": string(dsc.Managed)},
+			"ray":       map[string]any{"managementState": string(dsc.Managed)},
+		},
+	}
+
+	c, _ := newStatusClient(
+		newComponent(testKserveKind, "True"),
+		newComponent(testCodeFlareKind, "True"),
+		newComponent(testDashboardKind, "False"),
+	)
+
+	result, err := dsc.GetComponentStatuses(t.Context(), c, cluster)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Name).Should(Equal(testDSCName))
+	g.Expect(result.Errors).Should(BeEmpty())
+
+	g.Expect(result.Components).Should(HaveExactElements(
+		dsc.ComponentStatus{
+			Name:            "codeflare",
+			ManagementState: dsc.Removed,
+			Deployed:        true,
+			Ready:           "True",
+			Message:         "CodeFlare is True",
+			Drift:           "Removed but still deployed",
+		},
+		dsc.ComponentStatus{
+			Name:            "dashboard",
+			ManagementState: dsc.Managed,
+			Deployed:        true,
+			Ready:           "False",
+			Message:         "Dashboard is False",
+			Drift:           "Managed but not ready",
+		},
+		dsc.ComponentStatus{
+			Name:            "kserve",
+			ManagementState: dsc.Managed,
+			Deployed:        true,
+			Ready:           "True",
+			Message:         "Kserve is True",
+		},
+		dsc.ComponentStatus{
+			Name:            "ray",
+			ManagementState: dsc.Managed,
+			Drift:           "Managed but not deployed",
+		},
+	))
+}
+
+func TestGetComponentStatusesWithListErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := clientfake.NewDataScienceCluster(testDSCName)
+	cluster.Object["spec"] = map[string]any{
+		"components": map[string]any{
+			"kserve":    map[string]any{"managementState": string(dsc.Managed)},
+			"dashboard": map[string]any{"managementState": string(dsc.Managed)},
+		},
+	}
+
+	c, dynamicClient := newStatusClient(newComponent(testKserveKind, "True"))
+	dynamicClient.PrependReactor("list", testDashboardResource,
+		func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(
+				schema.GroupResource{Group: resources.ComponentsGroup, Resource: testDashboardResource}, "", errors.New("denied"),
+			)
+		},
+	)
+
+	result, err := dsc.GetComponentStatuses(t.Context(), c, cluster)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Errors).Should(HaveLen(1))
+
+	g.Expect(result.Components).Should(HaveExactElements(
+		And(
+			HaveField("Name", "dashboard"),
+			HaveField("Deployed", false),
+			HaveField("Ready", "Unknown"),
+			HaveField("Message", ContainSubstring("denied")),
+			HaveField("Drift", "Unknown"),
+		),
+		HaveField("Name", "kserve"),
+	))
+}
@@ -0,0 +1,89 @@
+package dsci
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+)
+
+// DefaultApplicationsNamespace is the namespace used by namespace-scoped commands when
+// neither an explicit namespace nor a DSCInitialization is available.
+const DefaultApplicationsNamespace = "opendatahub"
+
+// GetDSCInitialization retrieves the singleton DSCInitialization instance.
+func GetDSCInitialization(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+) (*unstructured.Unstructured, error) {
+	list, err := dynamicClient.Resource(resources.DSCInitialization).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	switch len(list.Items) {
+	case 0:
+		return nil, fmt.Errorf("no DSCInitialization found")
+	case 1:
+		return &list.Items[0], nil
+	default:
+		return nil, fmt.Errorf("expected a single DSCInitialization, found %d", len(list.Items))
+	}
+}
+
+// ApplicationsNamespace returns the applications namespace declared by the DSCInitialization,
+// or DefaultApplicationsNamespace when there is no DSCInitialization or it does not set one.
+func ApplicationsNamespace(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+) (string, error) {
+	list, err := dynamicClient.Resource(resources.DSCInitialization).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// The DSCInitialization API is not served, e.g. the operator is not installed.
+		if apierrors.IsNotFound(err) {
+			return DefaultApplicationsNamespace, nil
+		}
+
+		return "", fmt.Errorf("failed to list DSCInitialization: %w", err)
+	}
+
+	if len(list.Items) == 0 {
+		return DefaultApplicationsNamespace, nil
+	}
+
+	if len(list.Items) > 1 {
+		return "", fmt.Errorf("expected a single DSCInitialization, found %d", len(list.Items))
+	}
+
+	namespace, _, err := unstructured.NestedString(list.Items[0].Object, "spec", "applicationsNamespace")
+	if err != nil {
+		return "", fmt.Errorf("failed to read spec.applicationsNamespace: %w", err)
+	}
+
+	if namespace == "" {
+		return DefaultApplicationsNamespace, nil
+	}
+
+	return namespace, nil
+}
+
+// ResolveNamespace returns the namespace namespace-scoped commands should operate on:
+// the namespace explicitly set with --namespace if any, the applications namespace
+// declared by the DSCInitialization otherwise.
+func ResolveNamespace(
+	ctx context.Context,
+	configFlags *genericclioptions.ConfigFlags,
+	dynamicClient dynamic.Interface,
+) (string, error) {
+	if configFlags.Namespace != nil && *configFlags.Namespace != "" {
+		return *configFlags.Namespace, nil
+	}
+
+	return ApplicationsNamespace(ctx, dynamicClient)
+}
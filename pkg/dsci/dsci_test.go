@@ -0,0 +1,131 @@
+package dsci_test
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the DSCInitialization singleton.
+const (
+	testDSCIAPIVersion   = "dscinitialization.opendatahub.io/v1"
+	testDSCIKind         = "DSCInitialization"
+	testDSCIName         = "default-dsci"
+	testAppsNamespace    = "redhat-ods-applications"
+	testFlagNamespace    = "my-namespace"
+	testDSCIResourceName = "dscinitializations"
+)
+
+func newDSCI(name string, applicationsNamespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": testDSCIAPIVersion,
+			"kind":       testDSCIKind,
+			"metadata": map[string]any{
+				"name": name,
+			},
+			"spec": map[string]any{},
+		},
+	}
+
+	if applicationsNamespace != "" {
+		obj.Object["spec"] = map[string]any{
+			"applicationsNamespace": applicationsNamespace,
+		}
+	}
+
+	return obj
+}
+
+func TestGetDSCInitialization(t *testing.T) {
+	t.Run("should return the singleton instance", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(newDSCI(testDSCIName, testAppsNamespace)))
+
+		result, err := dsci.GetDSCInitialization(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.GetName()).Should(Equal(testDSCIName))
+	})
+
+	t.Run("should fail when no instance exists", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient()
+
+		_, err := dsci.GetDSCInitialization(t.Context(), client)
+		g.Expect(err).Should(MatchError(ContainSubstring("no DSCInitialization found")))
+	})
+}
+
+func TestApplicationsNamespace(t *testing.T) {
+	t.Run("should return the namespace declared by the DSCInitialization", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(newDSCI(testDSCIName, testAppsNamespace)))
+
+		namespace, err := dsci.ApplicationsNamespace(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(namespace).Should(Equal(testAppsNamespace))
+	})
+
+	t.Run("should fall back to the default when the field is not set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient(clientfake.WithObjects(newDSCI(testDSCIName, "")))
+
+		namespace, err := dsci.ApplicationsNamespace(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(namespace).Should(Equal(dsci.DefaultApplicationsNamespace))
+	})
+
+	t.Run("should fall back to the default when the API is not served", func(t *testing.T) {
+		g := NewWithT(t)
+
+		client := clientfake.NewDynamicClient()
+		client.PrependReactor("list", testDSCIResourceName,
+			func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewNotFound(resources.DSCInitialization.GroupResource(), "")
+			},
+		)
+
+		namespace, err := dsci.ApplicationsNamespace(t.Context(), client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(namespace).Should(Equal(dsci.DefaultApplicationsNamespace))
+	})
+}
+
+func TestResolveNamespace(t *testing.T) {
+	client := clientfake.NewDynamicClient(clientfake.WithObjects(newDSCI(testDSCIName, testAppsNamespace)))
+
+	t.Run("should prefer the explicit namespace", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := genericclioptions.NewConfigFlags(false)
+		*flags.Namespace = testFlagNamespace
+
+		namespace, err := dsci.ResolveNamespace(t.Context(), flags, client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(namespace).Should(Equal(testFlagNamespace))
+	})
+
+	t.Run("should use the applications namespace otherwise", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := genericclioptions.NewConfigFlags(false)
+
+		namespace, err := dsci.ResolveNamespace(t.Context(), flags, client)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(namespace).Should(Equal(testAppsNamespace))
+	})
+}
@@ -0,0 +1,345 @@
+package mustgather
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/operator"
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+// Top level directories of the must-gather layout, as read by the offline client.
+const (
+	clusterScopedDir = "cluster-scoped-resources"
+	namespacedDir    = "namespaces"
+	coreGroupDir     = "core"
+)
+
+// collector holds the state of a single collection.
+type collector struct {
+	client   *client.Client
+	writer   Writer
+	options  Options
+	redactor *redact.Redactor
+	manifest *Manifest
+}
+
+// Collect captures the ODH/RHOAI resources and operator logs using the must-gather layout:
+//
+//	cluster-scoped-resources/<group>/<resource>/<name>.yaml
+//	namespaces/<namespace>/<group>/<resource>/<name>.yaml
+//	namespaces/<namespace>/pods/<pod>/<container>/<container>/logs/current.log
+//
+// where the core group is stored as "core". Objects and logs are always redacted, see
+// the redact package for what is masked. Resources that cannot be collected are
+// recorded in the returned Manifest, which is also written as manifest.yaml.
+// An error is only returned when the must-gather cannot be written.
+func Collect(
+	ctx context.Context,
+	client *client.Client,
+	writer Writer,
+	opts ...Option,
+) (*Manifest, error) {
+	options := newOptions(opts...)
+
+	redactor, err := redact.New(redact.WithPaths(options.RedactPaths...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure redaction: %w", err)
+	}
+
+	c := &collector{
+		client:   client,
+		writer:   writer,
+		options:  options,
+		redactor: redactor,
+		manifest: &Manifest{
+			CollectedAt:           options.Now().UTC().Format(time.RFC3339),
+			ApplicationsNamespace: options.Namespace,
+			Redacted:              true,
+			RedactedPaths:         options.RedactPaths,
+			Captured:              []CapturedSet{},
+		},
+	}
+
+	steps := []func(context.Context) error{
+		c.collectComponents,
+		c.collectPlatform,
+		c.collectOperator,
+		c.collectApplications,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := yaml.Marshal(c.manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	if err := writer.WriteFile(ManifestFileName, data); err != nil {
+		return nil, err
+	}
+
+	return c.manifest, nil
+}
+
+// collectComponents captures every object of the components.platform.opendatahub.io group.
+func (c *collector) collectComponents(ctx context.Context) error {
+	componentResources, err := components.DiscoverResources(c.client)
+	if err != nil {
+		c.fail(schema.GroupResource{Group: resources.ComponentsGroup}.String(), "", err)
+
+		return nil
+	}
+
+	for _, resource := range componentResources {
+		if _, err := c.collect(ctx, components.ComponentGVR(resource), metav1.NamespaceAll, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectPlatform captures the DataScienceCluster and the DSCInitialization.
+func (c *collector) collectPlatform(ctx context.Context) error {
+	for _, gvr := range []schema.GroupVersionResource{resources.DataScienceCluster, resources.DSCInitialization} {
+		if _, err := c.collect(ctx, gvr, metav1.NamespaceAll, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectOperator captures the operator Subscription, ClusterServiceVersion and
+// Deployment together with the logs of the operator pods.
+func (c *collector) collectOperator(ctx context.Context) error {
+	if _, err := c.collect(ctx, resources.Subscription, metav1.NamespaceAll, operator.IsSubscription); err != nil {
+		return err
+	}
+
+	if _, err := c.collect(ctx, resources.ClusterServiceVersion, metav1.NamespaceAll, operator.IsClusterServiceVersion); err != nil {
+		return err
+	}
+
+	deployments, err := c.collect(ctx, resources.Deployment, metav1.NamespaceAll, operator.IsDeployment)
+	if err != nil {
+		return err
+	}
+
+	for i := range deployments {
+		if err := c.collectDeploymentPods(ctx, &deployments[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectApplications captures the Deployments, Events and Secrets of the applications namespace.
+func (c *collector) collectApplications(ctx context.Context) error {
+	for _, gvr := range []schema.GroupVersionResource{resources.Deployment, resources.Event, resources.Secret} {
+		if _, err := c.collect(ctx, gvr, c.options.Namespace, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectDeploymentPods captures the pods of a deployment and the logs of their containers.
+func (c *collector) collectDeploymentPods(ctx context.Context, deployment *unstructured.Unstructured) error {
+	namespace := deployment.GetNamespace()
+
+	selector, err := deploymentSelector(deployment)
+	if err != nil {
+		c.fail(resources.Pod.GroupResource().String(), namespace, err)
+
+		return nil
+	}
+
+	pods, err := c.collectList(ctx, resources.Pod, namespace, metav1.ListOptions{LabelSelector: selector}, nil)
+	if err != nil {
+		return err
+	}
+
+	logs := resources.Pod.GroupResource().String() + "/log"
+
+	if c.client.Kubernetes == nil {
+		if len(pods) > 0 {
+			c.fail(logs, namespace, fmt.Errorf("pod logs are not available from this client"))
+		}
+
+		return nil
+	}
+
+	count := 0
+
+	for i := range pods {
+		containers, _, _ := unstructured.NestedSlice(pods[i].Object, "spec", "containers")
+
+		for _, item := range containers {
+			fields, _ := item.(map[string]any)
+
+			container, _ := fields["name"].(string)
+			if container == "" {
+				continue
+			}
+
+			data, err := c.client.Kubernetes.CoreV1().
+				Pods(namespace).
+				GetLogs(pods[i].GetName(), &corev1.PodLogOptions{Container: container}).
+				DoRaw(ctx)
+			if err != nil {
+				c.fail(logs, namespace, fmt.Errorf("pod %s container %s: %w", pods[i].GetName(), container, err))
+
+				continue
+			}
+
+			name := path.Join(namespacedDir, namespace, "pods", pods[i].GetName(), container, container, "logs", "current.log")
+			if err := c.writer.WriteFile(name, []byte(c.redactor.String(string(data)))); err != nil {
+				return err
+			}
+
+			count++
+		}
+	}
+
+	c.capture(logs, namespace, count)
+
+	return nil
+}
+
+// collect captures the objects of a resource, optionally filtered, and returns them.
+func (c *collector) collect(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	namespace string,
+	filter func(*unstructured.Unstructured) bool,
+) ([]unstructured.Unstructured, error) {
+	return c.collectList(ctx, gvr, namespace, metav1.ListOptions{}, filter)
+}
+
+func (c *collector) collectList(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	namespace string,
+	listOptions metav1.ListOptions,
+	filter func(*unstructured.Unstructured) bool,
+) ([]unstructured.Unstructured, error) {
+	resource := gvr.GroupResource().String()
+
+	list, err := c.client.Dynamic.Resource(gvr).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		c.fail(resource, namespace, err)
+
+		return nil, nil
+	}
+
+	collected := make([]unstructured.Unstructured, 0, len(list.Items))
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+
+		if filter != nil && !filter(obj) {
+			continue
+		}
+
+		if err := c.write(gvr, obj); err != nil {
+			return nil, err
+		}
+
+		collected = append(collected, *obj)
+	}
+
+	c.capture(resource, namespace, len(collected))
+
+	return collected, nil
+}
+
+// write stores a redacted copy of an object at its must-gather path, without its managed fields.
+func (c *collector) write(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) error {
+	redacted, err := c.redactor.Unstructured(obj)
+	if err != nil {
+		return fmt.Errorf("failed to redact %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	redacted.SetManagedFields(nil)
+
+	data, err := yaml.Marshal(redacted.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	return c.writer.WriteFile(ResourcePath(gvr, redacted), data)
+}
+
+func (c *collector) capture(resource string, namespace string, count int) {
+	c.manifest.Captured = append(c.manifest.Captured, CapturedSet{
+		Resource:  resource,
+		Namespace: namespace,
+		Count:     count,
+	})
+}
+
+func (c *collector) fail(resource string, namespace string, err error) {
+	c.manifest.Failed = append(c.manifest.Failed, FailedSet{
+		Resource:  resource,
+		Namespace: namespace,
+		Error:     err.Error(),
+	})
+}
+
+// ResourcePath returns the must-gather path of an object of the given resource.
+func ResourcePath(gvr schema.GroupVersionResource, obj *unstructured.Unstructured) string {
+	group := gvr.Group
+	if group == "" {
+		group = coreGroupDir
+	}
+
+	if obj.GetNamespace() == "" {
+		return path.Join(clusterScopedDir, group, gvr.Resource, obj.GetName()+".yaml")
+	}
+
+	return path.Join(namespacedDir, obj.GetNamespace(), group, gvr.Resource, obj.GetName()+".yaml")
+}
+
+// deploymentSelector returns the label selector of a deployment in string form.
+func deploymentSelector(deployment *unstructured.Unstructured) (string, error) {
+	rawSelector, found, err := unstructured.NestedMap(deployment.Object, "spec", "selector")
+	if err != nil {
+		return "", fmt.Errorf("failed to read selector of deployment %s: %w", deployment.GetName(), err)
+	}
+
+	if !found {
+		return "", fmt.Errorf("deployment %s has no selector", deployment.GetName())
+	}
+
+	var labelSelector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, &labelSelector); err != nil {
+		return "", fmt.Errorf("failed to decode selector of deployment %s: %w", deployment.GetName(), err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("invalid selector on deployment %s: %w", deployment.GetName(), err)
+	}
+
+	return selector.String(), nil
+}
@@ -0,0 +1,58 @@
+package mustgather
+
+import (
+	"time"
+
+	"github.com/lburgazzoli/odh-cli/pkg/dsci"
+	"github.com/lburgazzoli/odh-cli/pkg/util"
+)
+
+// Options holds the configuration of a must-gather collection.
+type Options struct {
+	// Namespace is the applications namespace whose Deployments, Events and Secrets are collected.
+	Namespace string
+	// RedactPaths are jq path expressions of additional fields to mask, on top of the
+	// Secret data, bearer tokens and URL credentials which are always redacted.
+	RedactPaths []string
+	// Now returns the current time, it is used to timestamp the manifest.
+	Now func() time.Time
+}
+
+// Option is a functional option for configuring a must-gather collection.
+type Option = util.Option[Options]
+
+// WithNamespace sets the applications namespace. Empty values are ignored.
+func WithNamespace(namespace string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		if namespace != "" {
+			o.Namespace = namespace
+		}
+	})
+}
+
+// WithRedactPaths adds jq path expressions of fields to mask.
+func WithRedactPaths(paths ...string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.RedactPaths = append(o.RedactPaths, paths...)
+	})
+}
+
+// WithNow sets the function returning the current time.
+func WithNow(now func() time.Time) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.Now = now
+	})
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		Namespace: dsci.DefaultApplicationsNamespace,
+		Now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(&options)
+	}
+
+	return options
+}
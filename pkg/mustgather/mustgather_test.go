@@ -0,0 +1,196 @@
+package mustgather_test
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/mustgather"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+	clientfake "github.com/lburgazzoli/odh-cli/pkg/util/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the collected cluster.
+const (
+	testKserveResource      = "kserves"
+	testKserveKind          = "Kserve"
+	testKserveName          = "default-kserve"
+	testAppsNamespace       = "opendatahub"
+	testOperatorNamespace   = "openshift-operators"
+	testOperatorDeployment  = "opendatahub-operator-controller-manager"
+	testOperatorPod         = "opendatahub-operator-controller-manager-abc"
+	testOperatorContainer   = "manager"
+	testOperatorLabel       = "opendatahub-operator"
+	testSecretName          = "dashboard-oauth"
+	testSecretKey           = "cookie-secret"
+	testSecretValue         = "c2VjcmV0"
+	testCollectedAt         = "2025-01-01T12:00:00Z"
+	testKservePath          = "cluster-scoped-resources/components.platform.opendatahub.io/kserves/default-kserve.yaml"
+	testOperatorPath        = "namespaces/openshift-operators/apps/deployments/opendatahub-operator-controller-manager.yaml"
+	testOperatorPodPath     = "namespaces/openshift-operators/core/pods/opendatahub-operator-controller-manager-abc.yaml"
+	testOperatorLogPath     = "namespaces/openshift-operators/pods/opendatahub-operator-controller-manager-abc/manager/manager/logs/current.log"
+	testSecretPath          = "namespaces/opendatahub/core/secrets/dashboard-oauth.yaml"
+	testUnrelatedDeployment = "unrelated"
+	testUnrelatedNamespace  = "default"
+	testUnrelatedPath       = "namespaces/default/apps/deployments/unrelated.yaml"
+)
+
+// memoryWriter keeps the files of a must-gather in memory.
+type memoryWriter map[string][]byte
+
+func (w memoryWriter) WriteFile(name string, data []byte) error {
+	w[name] = data
+
+	return nil
+}
+
+func (w memoryWriter) Close() error {
+	return nil
+}
+
+func newOperatorDeployment(namespace string, name string) *unstructured.Unstructured {
+	deployment := clientfake.NewObject("apps/v1", "Deployment", namespace, name)
+	deployment.Object["spec"] = map[string]any{
+		"selector": map[string]any{
+			"matchLabels": map[string]any{"app": testOperatorLabel},
+		},
+	}
+
+	return deployment
+}
+
+func newOperatorPod() *unstructured.Unstructured {
+	pod := clientfake.NewObject("v1", "Pod", testOperatorNamespace, testOperatorPod)
+	pod.SetLabels(map[string]string{"app": testOperatorLabel})
+	pod.Object["spec"] = map[string]any{
+		"containers": []any{
+			map[string]any{"name": testOperatorContainer},
+		},
+	}
+
+	return pod
+}
+
+func newSecret() *unstructured.Unstructured {
+	secret := clientfake.NewObject("v1", "Secret", testAppsNamespace, testSecretName)
+	secret.Object["data"] = map[string]any{
+		testSecretKey: testSecretValue,
+	}
+
+	return secret
+}
+
+func newClient() *client.Client {
+	c, _ := clientfake.NewClient(
+		clientfake.WithComponent(testKserveResource, testKserveKind),
+		clientfake.WithObjects(
+			clientfake.NewObject(clientfake.ComponentsAPIVersion, testKserveKind, "", testKserveName),
+			newOperatorDeployment(testOperatorNamespace, testOperatorDeployment),
+			newOperatorDeployment(testUnrelatedNamespace, testUnrelatedDeployment),
+			newOperatorPod(),
+			newSecret(),
+		),
+	)
+
+	return c
+}
+
+func collect(t *testing.T, c *client.Client, writer mustgather.Writer) *mustgather.Manifest {
+	t.Helper()
+
+	now, err := time.Parse(time.RFC3339, testCollectedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	manifest, err := mustgather.Collect(
+		t.Context(),
+		c,
+		writer,
+		mustgather.WithNamespace(testAppsNamespace),
+		mustgather.WithNow(func() time.Time { return now }),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return manifest
+}
+
+func TestCollect(t *testing.T) {
+	writer := memoryWriter{}
+	manifest := collect(t, newClient(), writer)
+
+	t.Run("should capture components and operator resources", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(writer).Should(HaveKey(testKservePath))
+		g.Expect(writer).Should(HaveKey(testOperatorPath))
+		g.Expect(writer).Should(HaveKey(testOperatorPodPath))
+		g.Expect(writer).ShouldNot(HaveKey(testUnrelatedPath))
+	})
+
+	t.Run("should capture the operator logs", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(writer).Should(HaveKey(testOperatorLogPath))
+	})
+
+	t.Run("should redact secrets", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(writer).Should(HaveKey(testSecretPath))
+
+		secret := map[string]any{}
+		g.Expect(yaml.Unmarshal(writer[testSecretPath], &secret)).Should(Succeed())
+		g.Expect(secret).Should(HaveKeyWithValue("data", HaveKeyWithValue(testSecretKey, "REDACTED")))
+	})
+
+	t.Run("should write the manifest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(writer).Should(HaveKey(mustgather.ManifestFileName))
+		g.Expect(manifest.CollectedAt).Should(Equal(testCollectedAt))
+		g.Expect(manifest.ApplicationsNamespace).Should(Equal(testAppsNamespace))
+		g.Expect(manifest.Redacted).Should(BeTrue())
+		g.Expect(manifest.Failed).Should(BeEmpty())
+		g.Expect(manifest.Captured).Should(ContainElement(mustgather.CapturedSet{
+			Resource:  "secrets",
+			Namespace: testAppsNamespace,
+			Count:     1,
+		}))
+	})
+
+	t.Run("should record what failed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := newClient()
+		c.Kubernetes = nil
+
+		failed := collect(t, c, memoryWriter{})
+		g.Expect(failed.Failed).Should(ContainElement(HaveField("Resource", "pods/log")))
+	})
+}
+
+func TestCollectRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	root := t.TempDir()
+	collect(t, newClient(), mustgather.NewDirWriter(root))
+
+	flags := client.NewFlags()
+	flags.FromDir = root
+
+	offline, err := client.NewClient(flags)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	result, err := components.ListComponents(t.Context(), offline)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(result.Items).Should(HaveLen(1))
+	g.Expect(result.Items[0].GetName()).Should(Equal(testKserveName))
+}
@@ -0,0 +1,28 @@
+package mustgather
+
+// ManifestFileName is the name of the file summarizing the content of a must-gather.
+const ManifestFileName = "manifest.yaml"
+
+// Manifest summarizes what a must-gather captured and what could not be collected.
+type Manifest struct {
+	CollectedAt           string        `json:"collectedAt"`
+	ApplicationsNamespace string        `json:"applicationsNamespace"`
+	Redacted              bool          `json:"redacted"`
+	RedactedPaths         []string      `json:"redactedPaths,omitempty"`
+	Captured              []CapturedSet `json:"captured"`
+	Failed                []FailedSet   `json:"failed,omitempty"`
+}
+
+// CapturedSet records how many objects of a resource were captured.
+type CapturedSet struct {
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Count     int    `json:"count"`
+}
+
+// FailedSet records a resource that could not be collected.
+type FailedSet struct {
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Error     string `json:"error"`
+}
@@ -0,0 +1,99 @@
+package mustgather
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+// Writer stores the files of a must-gather.
+type Writer interface {
+	// WriteFile stores data at the given slash separated path, relative to the must-gather root.
+	WriteFile(name string, data []byte) error
+	// Close flushes any pending data.
+	Close() error
+}
+
+// NewDirWriter returns a Writer storing files under the given directory.
+func NewDirWriter(root string) Writer {
+	return &dirWriter{root: root}
+}
+
+type dirWriter struct {
+	root string
+}
+
+func (w *dirWriter) WriteFile(name string, data []byte) error {
+	filePath := filepath.Join(w.root, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", name, err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (w *dirWriter) Close() error {
+	return nil
+}
+
+// NewArchiveWriter returns a Writer storing files in a .tar.gz stream, under the given prefix.
+// The underlying writer is not closed.
+func NewArchiveWriter(out io.Writer, prefix string) Writer {
+	gz := gzip.NewWriter(out)
+
+	return &archiveWriter{
+		gz:     gz,
+		tar:    tar.NewWriter(gz),
+		prefix: prefix,
+		now:    time.Now(),
+	}
+}
+
+type archiveWriter struct {
+	gz     *gzip.Writer
+	tar    *tar.Writer
+	prefix string
+	now    time.Time
+}
+
+func (w *archiveWriter) WriteFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:     path.Join(w.prefix, name),
+		Mode:     0o600,
+		Size:     int64(len(data)),
+		ModTime:  w.now,
+		Typeflag: tar.TypeReg,
+	}
+
+	if err := w.tar.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	if _, err := w.tar.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (w *archiveWriter) Close() error {
+	if err := w.tar.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+
+	if err := w.gz.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+
+	return nil
+}
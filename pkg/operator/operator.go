@@ -0,0 +1,49 @@
+package operator
+
+import (
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PackageNames are the OLM package names of the operators that install ODH/RHOAI.
+var PackageNames = []string{
+	"opendatahub-operator",
+	"rhods-operator",
+}
+
+// DeploymentNames are the names of the operator Deployments of ODH/RHOAI.
+var DeploymentNames = []string{
+	"opendatahub-operator-controller-manager",
+	"rhods-operator",
+}
+
+// copiedFromLabel marks the copies OLM creates of a ClusterServiceVersion in
+// every namespace watched by the operator.
+const copiedFromLabel = "olm.copiedFrom"
+
+// IsSubscription reports whether the given Subscription installs one of the ODH/RHOAI operators.
+func IsSubscription(subscription *unstructured.Unstructured) bool {
+	name, _, _ := unstructured.NestedString(subscription.Object, "spec", "name")
+
+	return slices.Contains(PackageNames, name)
+}
+
+// IsClusterServiceVersion reports whether the given ClusterServiceVersion belongs to one of the
+// ODH/RHOAI operators. The copies OLM creates in the watched namespaces are not considered.
+func IsClusterServiceVersion(csv *unstructured.Unstructured) bool {
+	if _, copied := csv.GetLabels()[copiedFromLabel]; copied {
+		return false
+	}
+
+	// ClusterServiceVersions are named <package>.<version>
+	packageName, _, found := strings.Cut(csv.GetName(), ".")
+
+	return found && slices.Contains(PackageNames, packageName)
+}
+
+// IsDeployment reports whether the given Deployment runs one of the ODH/RHOAI operators.
+func IsDeployment(deployment *unstructured.Unstructured) bool {
+	return slices.Contains(DeploymentNames, deployment.GetName())
+}
@@ -0,0 +1,115 @@
+package printer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
+)
+
+const (
+	// CustomColumns specifies the custom columns output format, followed by the
+	// column specification as in "custom-columns=NAME:.metadata.name,TYPE:.kind".
+	CustomColumns OutputFormat = "custom-columns"
+	// CustomColumnsFile specifies the custom columns output format, followed by
+	// the path of a file holding the column specification.
+	CustomColumnsFile OutputFormat = "custom-columns-file"
+)
+
+// CustomColumnsPrinter prints the rows of a Value as a table with user defined columns,
+// each one being a jq expression evaluated against the row.
+type CustomColumnsPrinter struct {
+	columns []table.Column
+}
+
+func newCustomColumnsPrinter(arg string, _ Config) (Printer, error) {
+	columns, err := ParseCustomColumns(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomColumnsPrinter{columns: columns}, nil
+}
+
+func newCustomColumnsFilePrinter(arg string, _ Config) (Printer, error) {
+	if arg == "" {
+		return nil, errors.New("custom-columns-file format specified but no file given")
+	}
+
+	data, err := os.ReadFile(arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom columns file: %w", err)
+	}
+
+	// Besides commas, columns can be separated by new lines in a file,
+	// and lines starting with # are comments.
+	var specs []string
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		specs = append(specs, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read custom columns file: %w", err)
+	}
+
+	return newCustomColumnsPrinter(strings.Join(specs, ","), Config{})
+}
+
+// Print implements Printer.
+func (p *CustomColumnsPrinter) Print(w io.Writer, value Value) error {
+	renderer := newRenderer(w, p.columns, value.TableOptions)
+
+	if err := renderer.AppendAll(value.Rows); err != nil {
+		return fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	return renderer.Render()
+}
+
+// Stream implements TabularPrinter.
+func (p *CustomColumnsPrinter) Stream(w io.Writer, value Value) (Stream, error) {
+	return newStream(w, p.columns, value.TableOptions, value.Rows)
+}
+
+// ParseCustomColumns parses a comma separated list of <header>:<jq expression> columns.
+// Commas nested in brackets, parentheses, braces or string literals of an expression
+// do not separate columns, e.g. "KINDS:[.items[].kind] | join(\",\")" is a single column.
+func ParseCustomColumns(spec string) ([]table.Column, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, errors.New("custom-columns format specified but no columns given")
+	}
+
+	parts := jq.Split(spec, ',')
+	columns := make([]table.Column, 0, len(parts))
+
+	for _, part := range parts {
+		header, expression, ok := strings.Cut(part, ":")
+
+		header = strings.TrimSpace(header)
+		expression = strings.TrimSpace(expression)
+
+		if !ok || header == "" || expression == "" {
+			return nil, fmt.Errorf("invalid custom column %q: expected <header>:<jq expression>", part)
+		}
+
+		if _, err := jq.Compile(expression, nil); err != nil {
+			return nil, fmt.Errorf("invalid custom column %q: %w", header, err)
+		}
+
+		columns = append(columns, table.NewColumn(header).JQ(expression))
+	}
+
+	return columns, nil
+}
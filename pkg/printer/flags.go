@@ -0,0 +1,124 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+)
+
+// Flags holds the command line flags selecting the output format.
+type Flags struct {
+	// OutputFormat is the selected output format.
+	OutputFormat OutputFormat
+	// RawOutput prints the string results of jq expressions without quotes.
+	RawOutput bool
+	// Variables are jq variables in the name=value form.
+	Variables []string
+	// Color controls whether table cells are colorized.
+	Color table.ColorMode
+
+	// capabilities are the renditions the command provides, which decide the formats it supports.
+	capabilities Capability
+}
+
+// NewFlags returns flags defaulting to the given output format, for a command providing the
+// given capabilities. Only the formats whose requirements are provided are offered.
+func NewFlags(format OutputFormat, capabilities ...Capability) Flags {
+	f := Flags{
+		OutputFormat: format,
+		Color:        table.ColorAuto,
+	}
+
+	for _, capability := range capabilities {
+		f.capabilities |= capability
+	}
+
+	return f
+}
+
+// AddFlags binds the output flags to the given flag set.
+func (f *Flags) AddFlags(flags *pflag.FlagSet) {
+	flags.VarP(
+		&f.OutputFormat,
+		"output",
+		"o",
+		fmt.Sprintf("Output format. One of: (%s)", strings.Join(FormatsFor(f.capabilities), ", ")),
+	)
+	flags.BoolVarP(&f.RawOutput, "raw-output", "r", f.RawOutput, "With -o jq, print strings without quotes")
+	flags.StringArrayVar(&f.Variables, "arg", f.Variables, "With -o jq, a name=value string variable available to the expression as $name (can be repeated)")
+	flags.Var(&f.Color, "color", "Colorize table output. One of: (auto, always, never); auto colorizes when writing to a terminal and NO_COLOR is not set")
+}
+
+// Validate checks that the command supports the selected output format and that a printer
+// can be created for it, so that unsupported formats are reported before doing any work.
+func (f *Flags) Validate() error {
+	if err := SupportsWith(f.OutputFormat, f.capabilities); err != nil {
+		return err
+	}
+
+	_, err := f.ToPrinter()
+
+	return err
+}
+
+// ToPrinter returns the printer of the selected output format.
+func (f *Flags) ToPrinter() (Printer, error) {
+	variables := make(map[string]string, len(f.Variables))
+
+	for _, variable := range f.Variables {
+		name, value, ok := strings.Cut(variable, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid jq variable %q: expected name=value", variable)
+		}
+
+		variables[name] = value
+	}
+
+	return NewPrinter(f.OutputFormat, Config{
+		RawOutput: f.RawOutput,
+		Variables: variables,
+		Color:     f.Color,
+	})
+}
+
+// Tabular reports whether the selected output format shows the rows of a value rather than its object.
+func (f *Flags) Tabular() bool {
+	p, err := f.ToPrinter()
+	if err != nil {
+		return false
+	}
+
+	_, ok := p.(TabularPrinter)
+
+	return ok
+}
+
+// Stream prints the rows of the given value in the selected output format, and returns a Stream
+// printing the rows appended to it. The selected output format must be tabular.
+func (f *Flags) Stream(w io.Writer, value Value) (Stream, error) {
+	p, err := f.ToPrinter()
+	if err != nil {
+		return nil, err
+	}
+
+	tabular, ok := p.(TabularPrinter)
+	if !ok {
+		return nil, fmt.Errorf("output format %s does not support streaming", f.OutputFormat.Name())
+	}
+
+	return tabular.Stream(w, value)
+}
+
+// Print writes the given value in the selected output format.
+func (f *Flags) Print(w io.Writer, value Value) error {
+	p, err := f.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	return p.Print(w, value)
+}
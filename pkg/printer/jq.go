@@ -0,0 +1,70 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
+)
+
+// JQPrinter prints every result of a jq expression applied to the JSON form of
+// the object of a Value, one result per line as the jq command line does.
+type JQPrinter struct {
+	program   *jq.Program
+	rawOutput bool
+}
+
+func newJQPrinter(arg string, config Config) (Printer, error) {
+	if arg == "" {
+		return nil, errors.New("jq format specified but no expression given")
+	}
+
+	program, err := jq.Compile(arg, config.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JQPrinter{
+		program:   program,
+		rawOutput: config.RawOutput,
+	}, nil
+}
+
+// Print implements Printer.
+func (p *JQPrinter) Print(w io.Writer, value Value) error {
+	// The object is converted to its JSON form first, so that the expression
+	// sees the same fields as -o json.
+	data, err := json.Marshal(value.Object)
+	if err != nil {
+		return fmt.Errorf("failed to encode as JSON: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var object any
+	if err := decoder.Decode(&object); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return p.program.Run(object, func(result any) error {
+		if s, ok := result.(string); ok && p.rawOutput {
+			_, err := fmt.Fprintln(w, s)
+
+			return err
+		}
+
+		if err := encoder.Encode(result); err != nil {
+			return fmt.Errorf("failed to encode jq result: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,549 @@
+package printer_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer"
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the printed value.
+const (
+	testName   = "default-dashboard"
+	testStatus = "Ready"
+	testFooter = "1 item(s)"
+)
+
+type testItem struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type testPrinter struct {
+	arg string
+}
+
+func (p *testPrinter) Print(w io.Writer, _ printer.Value) error {
+	_, err := io.WriteString(w, p.arg)
+
+	return err
+}
+
+func newValue() printer.Value {
+	items := []testItem{{Name: testName, Status: testStatus}}
+
+	return printer.Value{
+		Object: map[string]any{"items": items},
+		Rows:   printer.Rows(items),
+		Columns: []table.Column{
+			table.NewColumn("NAME").JQ(`.name`),
+			table.NewColumn("STATUS").JQ(`.status`),
+		},
+		Footer: func(w io.Writer) error {
+			_, err := io.WriteString(w, testFooter)
+
+			return err
+		},
+	}
+}
+
+func TestOutputFormat(t *testing.T) {
+	t.Run("should split name and argument", func(t *testing.T) {
+		g := NewWithT(t)
+
+		format := printer.OutputFormat("jsonpath={.items[0].name}")
+		g.Expect(format.Name()).Should(Equal("jsonpath"))
+		g.Expect(format.Arg()).Should(Equal("{.items[0].name}"))
+
+		g.Expect(printer.JSON.Name()).Should(Equal("json"))
+		g.Expect(printer.JSON.Arg()).Should(BeEmpty())
+	})
+
+	t.Run("should accept registered formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var format printer.OutputFormat
+
+		g.Expect(format.Set("yaml")).Should(Succeed())
+		g.Expect(format).Should(Equal(printer.YAML))
+	})
+
+	t.Run("should reject unknown formats and list the supported ones", func(t *testing.T) {
+		g := NewWithT(t)
+
+		format := printer.Table
+
+		err := format.Set("xml")
+		g.Expect(err).Should(MatchError(ContainSubstring("unsupported output format: xml (supported: ")))
+		g.Expect(err).Should(MatchError(ContainSubstring("json, jsonpath")))
+		g.Expect(format).Should(Equal(printer.Table))
+	})
+
+	t.Run("should reject arguments for formats without one", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := printer.NewFlags(printer.OutputFormat("json=x"))
+		g.Expect(flags.Validate()).ShouldNot(Succeed())
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("should create printers from registered factories", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := printer.NewRegistry()
+		registry.Register("test", func(arg string, _ printer.Config) (printer.Printer, error) {
+			if arg == "" {
+				return nil, errors.New("argument required")
+			}
+
+			return &testPrinter{arg: arg}, nil
+		})
+
+		g.Expect(registry.Formats()).Should(Equal([]string{"test"}))
+
+		p, err := registry.NewPrinter("test=hello", printer.Config{})
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		var buf bytes.Buffer
+		g.Expect(p.Print(&buf, printer.Value{})).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("hello"))
+
+		_, err = registry.NewPrinter("test", printer.Config{})
+		g.Expect(err).Should(MatchError(ContainSubstring("argument required")))
+	})
+
+	t.Run("should only offer the formats whose requirements are provided", func(t *testing.T) {
+		g := NewWithT(t)
+
+		registry := printer.NewRegistry()
+		registry.Register("object", func(string, printer.Config) (printer.Printer, error) { return &testPrinter{}, nil })
+		registry.Register("rows", func(string, printer.Config) (printer.Printer, error) {
+			return &testPrinter{}, nil
+		}, printer.TabularCapability)
+
+		g.Expect(registry.Formats()).Should(Equal([]string{"object", "rows"}))
+		g.Expect(registry.FormatsFor(0)).Should(Equal([]string{"object"}))
+		g.Expect(registry.FormatsFor(printer.TabularCapability)).Should(Equal([]string{"object", "rows"}))
+
+		g.Expect(registry.SupportsWith("rows", printer.TabularCapability)).Should(Succeed())
+		g.Expect(registry.SupportsWith("rows", printer.TextCapability)).
+			Should(MatchError(ContainSubstring("not supported by this command (supported: object)")))
+	})
+}
+
+func TestFlags(t *testing.T) {
+	t.Run("should reject the formats the command does not support", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := printer.NewFlags(printer.Text, printer.TabularCapability)
+		g.Expect(flags.Validate()).Should(MatchError(ContainSubstring("output format text is not supported")))
+
+		flags.OutputFormat = printer.Table
+		g.Expect(flags.Validate()).Should(Succeed())
+
+		flags = printer.NewFlags(printer.Table)
+		g.Expect(flags.Validate()).Should(HaveOccurred())
+	})
+
+	t.Run("should only list the supported formats in the help", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := printer.NewFlags(printer.JSON, printer.TabularCapability)
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.AddFlags(flagSet)
+
+		usage := flagSet.Lookup("output").Usage
+		g.Expect(usage).Should(ContainSubstring("table"))
+		g.Expect(usage).ShouldNot(ContainSubstring("text"))
+	})
+}
+
+func TestPrinters(t *testing.T) {
+	t.Run("should print the object as JSON", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.JSON)
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(MatchJSON(`{"items":[{"name":"default-dashboard","status":"Ready"}]}`))
+	})
+
+	t.Run("should print the object as YAML", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.YAML)
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(MatchYAML("items:\n- name: default-dashboard\n  status: Ready\n"))
+	})
+
+	t.Run("should print the rows as a table followed by the footer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Table)
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+
+		output := buf.String()
+		g.Expect(output).Should(ContainSubstring("NAME"))
+		g.Expect(output).Should(ContainSubstring(testName))
+		g.Expect(output).Should(ContainSubstring(testStatus))
+		g.Expect(output).Should(HaveSuffix(testFooter))
+	})
+
+	t.Run("should only print wide columns in wide output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		value := newValue()
+		value.Columns = append(value.Columns, table.NewColumn("DETAILS").JQ(`"details of \(.name)"`).Wide())
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Table)
+		g.Expect(flags.Print(&buf, value)).Should(Succeed())
+		g.Expect(buf.String()).ShouldNot(ContainSubstring("DETAILS"))
+
+		buf.Reset()
+
+		flags = printer.NewFlags(printer.Wide)
+		g.Expect(flags.Print(&buf, value)).Should(Succeed())
+		g.Expect(buf.String()).Should(ContainSubstring("DETAILS"))
+		g.Expect(buf.String()).Should(ContainSubstring("details of " + testName))
+	})
+
+	t.Run("should report tabular formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for format, tabular := range map[printer.OutputFormat]bool{
+			printer.Table:                       true,
+			printer.Wide:                        true,
+			"custom-columns=NAME:.name":         true,
+			printer.CSV:                         true,
+			printer.Markdown:                    true,
+			printer.JSON:                        false,
+			printer.YAML:                        false,
+			"jsonpath={.items[*].name}":         false,
+			"custom-columns=NAME:.name[invalid": false,
+		} {
+			flags := printer.NewFlags(format)
+			g.Expect(flags.Tabular()).Should(Equal(tabular), string(format))
+		}
+	})
+
+	t.Run("should stream rows in tabular formats", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Table)
+
+		stream, err := flags.Stream(&buf, newValue())
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(buf.String()).Should(Equal(
+			"NAME                STATUS\n" +
+				testName + "   " + testStatus + "\n",
+		))
+
+		buf.Reset()
+
+		g.Expect(stream.Append(testItem{Name: "default-kserve", Status: "NotReady"})).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("default-kserve      NotReady\n"))
+
+		flags = printer.NewFlags(printer.JSON)
+
+		_, err = flags.Stream(&buf, newValue())
+		g.Expect(err).Should(MatchError(ContainSubstring("does not support streaming")))
+	})
+
+	t.Run("should print the text rendition of the value", func(t *testing.T) {
+		g := NewWithT(t)
+
+		value := newValue()
+		value.Text = func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "%s is %s\n", testName, testStatus)
+
+			return err
+		}
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Text, printer.TextCapability)
+		g.Expect(flags.Print(&buf, value)).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testName + " is " + testStatus + "\n"))
+
+		g.Expect(flags.Print(&buf, newValue())).Should(MatchError(ContainSubstring("text output is not supported")))
+	})
+
+	t.Run("should fail to print a table without columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Table)
+		g.Expect(flags.Print(&buf, printer.Value{Object: map[string]any{}})).ShouldNot(Succeed())
+	})
+}
+
+func TestTemplatePrinters(t *testing.T) {
+	t.Run("should print the object with a jsonpath template", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat("jsonpath={.items[*].name}"))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testName))
+	})
+
+	t.Run("should print the object with a go template", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`go-template={{range .items}}{{.name}}={{.status}}{{end}}`))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testName + "=" + testStatus))
+	})
+
+	t.Run("should read the template from a file", func(t *testing.T) {
+		g := NewWithT(t)
+
+		path := filepath.Join(t.TempDir(), "template")
+		g.Expect(os.WriteFile(path, []byte("{.items[0].status}"), 0o600)).Should(Succeed())
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat("jsonpath-file=" + path))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testStatus))
+	})
+
+	t.Run("should reject missing or invalid templates", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for format, message := range map[string]string{
+			"jsonpath":            "no template given",
+			"jsonpath={.items[":   "error parsing jsonpath",
+			"go-template={{.name": "error parsing template",
+		} {
+			flags := printer.NewFlags(printer.OutputFormat(format))
+			g.Expect(flags.Validate()).Should(MatchError(ContainSubstring(message)), format)
+		}
+	})
+}
+
+func TestJQPrinter(t *testing.T) {
+	t.Run("should print every result of the expression", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`jq=.items[] | .name, {status}`))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("\"" + testName + "\"\n{\n  \"status\": \"" + testStatus + "\"\n}\n"))
+	})
+
+	t.Run("should print strings without quotes with raw output", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`jq=.items[].name, 1`))
+		flags.RawOutput = true
+
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testName + "\n1\n"))
+	})
+
+	t.Run("should expose variables to the expression", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`jq=.items[] | select(.name == $name) | .status`))
+		flags.RawOutput = true
+		flags.Variables = []string{"name=" + testName}
+
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(testStatus + "\n"))
+	})
+
+	t.Run("should reject invalid expressions and variables", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, flags := range []printer.Flags{
+			printer.NewFlags(printer.JQ),
+			printer.NewFlags(printer.OutputFormat("jq=.items[")),
+			printer.NewFlags(printer.OutputFormat("jq=$undefined")),
+			{OutputFormat: printer.OutputFormat("jq=$name"), Variables: []string{"name"}},
+		} {
+			g.Expect(flags.Validate()).ShouldNot(Succeed(), string(flags.OutputFormat))
+		}
+	})
+
+	t.Run("should fail on runtime errors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`jq=.items + 1`))
+		g.Expect(flags.Print(&buf, newValue())).Should(MatchError(ContainSubstring("jq query error")))
+	})
+}
+
+func TestCustomColumnsPrinter(t *testing.T) {
+	t.Run("should print the rows with the given columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat(`custom-columns=ITEM:.name,SUMMARY:[.name, .status] | join(",")`))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+
+		output := buf.String()
+		g.Expect(output).Should(ContainSubstring("ITEM"))
+		g.Expect(output).Should(ContainSubstring("SUMMARY"))
+		g.Expect(output).Should(ContainSubstring(testName + "," + testStatus))
+		g.Expect(output).ShouldNot(ContainSubstring(testFooter))
+	})
+
+	t.Run("should read the columns from a file", func(t *testing.T) {
+		g := NewWithT(t)
+
+		path := filepath.Join(t.TempDir(), "columns")
+		g.Expect(os.WriteFile(path, []byte("# item columns\nITEM:.name\nPHASE:.status\n"), 0o600)).Should(Succeed())
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.OutputFormat("custom-columns-file=" + path))
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+
+		output := buf.String()
+		g.Expect(output).Should(ContainSubstring("PHASE"))
+		g.Expect(output).Should(ContainSubstring(testStatus))
+	})
+
+	t.Run("should not split columns on nested commas", func(t *testing.T) {
+		g := NewWithT(t)
+
+		columns, err := printer.ParseCustomColumns(`A:{a: .x, b: .y},B:select(.s == "x,y"),C:[.a, .b]`)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(columns).Should(HaveLen(3))
+	})
+
+	t.Run("should reject invalid columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, spec := range []string{"", "NAME", ":.name", "NAME:", "NAME:.items["} {
+			_, err := printer.ParseCustomColumns(spec)
+			g.Expect(err).Should(HaveOccurred(), spec)
+		}
+	})
+}
+
+func TestRowWriterPrinter(t *testing.T) {
+	newEscapedValue := func() printer.Value {
+		value := newValue()
+		value.Rows = printer.Rows([]testItem{{Name: `a "quoted", | piped`, Status: "multi\nline"}})
+		value.Columns = append(value.Columns, table.NewColumn("DETAILS").JQ(`"details of \(.name)"`).Wide())
+
+		return value
+	}
+
+	t.Run("should print the rows as CSV with all columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.CSV)
+		g.Expect(flags.Print(&buf, newEscapedValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(
+			"NAME,STATUS,DETAILS\n" +
+				`"a ""quoted"", | piped","multi` + "\n" + `line","details of a ""quoted"", | piped"` + "\n",
+		))
+	})
+
+	t.Run("should print the rows as TSV", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.TSV)
+		g.Expect(flags.Print(&buf, newValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("NAME\tSTATUS\n" + testName + "\t" + testStatus + "\n"))
+	})
+
+	t.Run("should print the rows as a Markdown table", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Markdown)
+		g.Expect(flags.Print(&buf, newEscapedValue())).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(
+			"| NAME | STATUS | DETAILS |\n" +
+				"| --- | --- | --- |\n" +
+				`| a "quoted", \| piped | multi<br>line | details of a "quoted", \| piped |` + "\n",
+		))
+	})
+
+	t.Run("should apply table options and column formatters", func(t *testing.T) {
+		g := NewWithT(t)
+
+		value := newValue()
+		value.Rows = printer.Rows([]testItem{{Name: "b", Status: "True"}, {Name: "a", Status: "False"}})
+		value.Columns[1] = table.NewColumn("STATUS").JQ(`.status`).Fn(table.StatusFormatter())
+		value.TableOptions = []table.Option[any]{
+			table.WithSortBy[any](table.SortKey{Key: "NAME"}),
+			table.WithNoHeaders[any](),
+		}
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.CSV)
+		g.Expect(flags.Print(&buf, value)).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("a,False\nb,True\n"))
+
+		buf.Reset()
+
+		flags = printer.NewFlags(printer.Markdown)
+		g.Expect(flags.Print(&buf, value)).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("| a | ❌ False |\n| b | ✅ True |\n"))
+	})
+
+	t.Run("should stream rows", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.CSV)
+
+		stream, err := flags.Stream(&buf, printer.Value{Columns: newValue().Columns})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(stream.Append(testItem{Name: testName, Status: testStatus})).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("NAME,STATUS\n" + testName + "," + testStatus + "\n"))
+	})
+
+	t.Run("should fail without columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+
+		flags := printer.NewFlags(printer.Markdown)
+		g.Expect(flags.Print(&buf, printer.Value{Object: map[string]any{}})).Should(MatchError(ContainSubstring("not supported")))
+	})
+}
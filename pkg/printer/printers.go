@@ -0,0 +1,136 @@
+package printer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+)
+
+// JSONPrinter prints the object of a Value as indented JSON.
+type JSONPrinter struct{}
+
+// Print implements Printer.
+func (p *JSONPrinter) Print(w io.Writer, value Value) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(value.Object); err != nil {
+		return fmt.Errorf("failed to encode as JSON: %w", err)
+	}
+
+	return nil
+}
+
+// YAMLPrinter prints the object of a Value as YAML.
+type YAMLPrinter struct{}
+
+// Print implements Printer.
+func (p *YAMLPrinter) Print(w io.Writer, value Value) error {
+	data, err := yaml.Marshal(value.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal as YAML: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+
+	return nil
+}
+
+// TextPrinter prints a Value with its own plain text rendition.
+type TextPrinter struct{}
+
+// Print implements Printer.
+func (p *TextPrinter) Print(w io.Writer, value Value) error {
+	if value.Text == nil {
+		return errors.New("text output is not supported")
+	}
+
+	return value.Text(w)
+}
+
+// TablePrinter prints the rows of a Value as a table, followed by its footer.
+// Columns marked as wide are only shown if Wide is set.
+type TablePrinter struct {
+	Wide  bool
+	Color table.ColorMode
+}
+
+func newTablePrinter(name OutputFormat, wide bool) Factory {
+	return func(arg string, config Config) (Printer, error) {
+		if arg != "" {
+			return nil, fmt.Errorf("output format %s does not take an argument", name)
+		}
+
+		return &TablePrinter{Wide: wide, Color: config.Color}, nil
+	}
+}
+
+// Print implements Printer.
+func (p *TablePrinter) Print(w io.Writer, value Value) error {
+	if len(value.Columns) == 0 {
+		return errors.New("table output is not supported")
+	}
+
+	options := append([]table.Option[any]{table.WithColor[any](p.Color)}, value.TableOptions...)
+	renderer := newRenderer(w, table.SelectColumns(value.Columns, p.Wide), options)
+
+	if err := renderer.AppendAll(value.Rows); err != nil {
+		return fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	if err := renderer.Render(); err != nil {
+		return err
+	}
+
+	if value.Footer != nil {
+		return value.Footer(w)
+	}
+
+	return nil
+}
+
+// Stream implements TabularPrinter. Rows are printed the same way kubectl does when watching,
+// with the column widths computed from the rows of the given value.
+func (p *TablePrinter) Stream(w io.Writer, value Value) (Stream, error) {
+	if len(value.Columns) == 0 {
+		return nil, errors.New("table output is not supported")
+	}
+
+	options := append([]table.Option[any]{table.WithColor[any](p.Color)}, value.TableOptions...)
+
+	return newStream(w, table.SelectColumns(value.Columns, p.Wide), options, value.Rows)
+}
+
+// newStream returns a streaming table renderer for the given columns and additional options,
+// after printing the given rows.
+func newStream(w io.Writer, columns []table.Column, options []table.Option[any], rows []any) (Stream, error) {
+	renderer := newRenderer(w, columns, append(options, table.WithStreaming[any](len(rows))))
+
+	if err := renderer.AppendAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	if len(rows) > 0 {
+		if err := renderer.Render(); err != nil {
+			return nil, err
+		}
+	}
+
+	return renderer, nil
+}
+
+// newRenderer returns a table renderer for the given columns and additional options.
+func newRenderer(w io.Writer, columns []table.Column, options []table.Option[any]) *table.Renderer[any] {
+	opts := table.ColumnOptions[any](columns...)
+	opts = append(opts, table.WithWriter[any](w))
+	opts = append(opts, options...)
+
+	return table.NewRenderer[any](opts...)
+}
@@ -0,0 +1,186 @@
+package printer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var defaultRegistry = newDefaultRegistry()
+
+// Registry maps output format names to the factories of their printers, and to the
+// capabilities they require from the commands.
+type Registry struct {
+	lock         sync.RWMutex
+	factories    map[string]Factory
+	requirements map[string]Capability
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories:    make(map[string]Factory),
+		requirements: make(map[string]Capability),
+	}
+}
+
+// newDefaultRegistry returns a registry holding the built-in printers.
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(JSON.Name(), noArg(JSON, &JSONPrinter{}))
+	r.Register(YAML.Name(), noArg(YAML, &YAMLPrinter{}))
+	r.Register(Table.Name(), newTablePrinter(Table, false), TabularCapability)
+	r.Register(Wide.Name(), newTablePrinter(Wide, true), TabularCapability)
+	r.Register(Text.Name(), noArg(Text, &TextPrinter{}), TextCapability)
+
+	registerTemplatePrinters(r)
+	r.Register(JQ.Name(), newJQPrinter)
+	r.Register(CustomColumns.Name(), newCustomColumnsPrinter, TabularCapability)
+	r.Register(CustomColumnsFile.Name(), newCustomColumnsFilePrinter, TabularCapability)
+	r.Register(CSV.Name(), noArg(CSV, newRowWriterPrinter(CSV, csvWriter(','))), TabularCapability)
+	r.Register(TSV.Name(), noArg(TSV, newRowWriterPrinter(TSV, csvWriter('\t'))), TabularCapability)
+	r.Register(Markdown.Name(), noArg(Markdown, newRowWriterPrinter(Markdown, markdownWriter)), TabularCapability)
+
+	return r
+}
+
+// Register adds a printer factory for the given format name, replacing any existing one.
+// The format is only supported by the commands providing the given capabilities.
+func (r *Registry) Register(name string, factory Factory, requires ...Capability) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var requirement Capability
+	for _, capability := range requires {
+		requirement |= capability
+	}
+
+	r.factories[name] = factory
+	r.requirements[name] = requirement
+}
+
+// Formats returns the sorted names of the registered formats.
+func (r *Registry) Formats() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// FormatsFor returns the sorted names of the registered formats supported by a command
+// providing the given capabilities.
+func (r *Registry) FormatsFor(capabilities Capability) []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		if capabilities.Has(r.requirements[name]) {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// SupportsWith reports whether a printer is registered for the name of the given output
+// format, and whether a command providing the given capabilities supports it.
+func (r *Registry) SupportsWith(format OutputFormat, capabilities Capability) error {
+	if err := r.Supports(format); err != nil {
+		return err
+	}
+
+	r.lock.RLock()
+	requirement := r.requirements[format.Name()]
+	r.lock.RUnlock()
+
+	if !capabilities.Has(requirement) {
+		return fmt.Errorf(
+			"output format %s is not supported by this command (supported: %s)",
+			format.Name(),
+			strings.Join(r.FormatsFor(capabilities), ", "),
+		)
+	}
+
+	return nil
+}
+
+// Supports reports whether a printer is registered for the name of the given output format.
+func (r *Registry) Supports(format OutputFormat) error {
+	_, err := r.factory(format)
+
+	return err
+}
+
+// NewPrinter returns the printer for the given output format.
+func (r *Registry) NewPrinter(format OutputFormat, config Config) (Printer, error) {
+	factory, err := r.factory(format)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := factory(format.Arg(), config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output format %s: %w", format, err)
+	}
+
+	return p, nil
+}
+
+func (r *Registry) factory(format OutputFormat) (Factory, error) {
+	r.lock.RLock()
+	factory, ok := r.factories[format.Name()]
+	r.lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported output format: %s (supported: %s)",
+			format,
+			strings.Join(r.Formats(), ", "),
+		)
+	}
+
+	return factory, nil
+}
+
+// Register adds a printer factory to the default registry.
+func Register(name string, factory Factory, requires ...Capability) {
+	defaultRegistry.Register(name, factory, requires...)
+}
+
+// Formats returns the sorted names of the formats of the default registry.
+func Formats() []string {
+	return defaultRegistry.Formats()
+}
+
+// FormatsFor returns the sorted names of the formats of the default registry supported by
+// a command providing the given capabilities.
+func FormatsFor(capabilities Capability) []string {
+	return defaultRegistry.FormatsFor(capabilities)
+}
+
+// Supports reports whether the default registry has a printer for the name of the given output format.
+func Supports(format OutputFormat) error {
+	return defaultRegistry.Supports(format)
+}
+
+// SupportsWith reports whether the default registry has a printer for the name of the given
+// output format, supported by a command providing the given capabilities.
+func SupportsWith(format OutputFormat, capabilities Capability) error {
+	return defaultRegistry.SupportsWith(format, capabilities)
+}
+
+// NewPrinter returns the printer of the default registry for the given output format.
+func NewPrinter(format OutputFormat, config Config) (Printer, error) {
+	return defaultRegistry.NewPrinter(format, config)
+}
@@ -0,0 +1,68 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
+)
+
+const (
+	// CSV specifies the comma separated values output format.
+	CSV OutputFormat = "csv"
+	// TSV specifies the tab separated values output format.
+	TSV OutputFormat = "tsv"
+	// Markdown specifies the Markdown table output format.
+	Markdown OutputFormat = "markdown"
+)
+
+// RowWriterPrinter prints the rows of a Value with the columns of the table output, in a format
+// other than a rendered table, e.g. CSV. All columns are printed, including wide ones, and the
+// footer is not, so that the output can be pasted into spreadsheets or issues as is.
+type RowWriterPrinter struct {
+	format    OutputFormat
+	newWriter func(w io.Writer) table.RowWriter
+}
+
+func newRowWriterPrinter(format OutputFormat, newWriter func(w io.Writer) table.RowWriter) *RowWriterPrinter {
+	return &RowWriterPrinter{format: format, newWriter: newWriter}
+}
+
+func csvWriter(comma rune) func(w io.Writer) table.RowWriter {
+	return func(w io.Writer) table.RowWriter {
+		return table.NewCSVWriter(w, comma)
+	}
+}
+
+func markdownWriter(w io.Writer) table.RowWriter {
+	return table.NewMarkdownWriter(w)
+}
+
+// Print implements Printer.
+func (p *RowWriterPrinter) Print(w io.Writer, value Value) error {
+	if len(value.Columns) == 0 {
+		return fmt.Errorf("%s output is not supported", p.format)
+	}
+
+	renderer := newRenderer(w, value.Columns, p.options(w, value))
+
+	if err := renderer.AppendAll(value.Rows); err != nil {
+		return fmt.Errorf("failed to append rows: %w", err)
+	}
+
+	return renderer.Render()
+}
+
+// Stream implements TabularPrinter.
+func (p *RowWriterPrinter) Stream(w io.Writer, value Value) (Stream, error) {
+	if len(value.Columns) == 0 {
+		return nil, fmt.Errorf("%s output is not supported", p.format)
+	}
+
+	return newStream(w, value.Columns, p.options(w, value), value.Rows)
+}
+
+// options returns the renderer options writing rows to w in the format of the printer.
+func (p *RowWriterPrinter) options(w io.Writer, value Value) []table.Option[any] {
+	return append([]table.Option[any]{table.WithRowWriter[any](p.newWriter(w))}, value.TableOptions...)
+}
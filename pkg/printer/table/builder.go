@@ -9,6 +9,9 @@ import (
 type Column struct {
 	name       string
 	formatters []ColumnFormatter
+	wide       bool
+	overflow   Overflow
+	maxWidth   int
 }
 
 // NewColumn creates a new column with the specified header name.
@@ -34,6 +37,52 @@ func (c Column) Fn(formatter ColumnFormatter) Column {
 	return c
 }
 
+// Wide marks this column as only shown in wide output (-o wide).
+// Can be chained with other formatters: Column().JQ(...).Wide()
+func (c Column) Wide() Column {
+	c.wide = true
+	return c
+}
+
+// Truncate lets the column be shrunk, truncating its cells, when the table is wider than the terminal.
+// Can be chained with other formatters: Column().JQ(...).Truncate()
+func (c Column) Truncate() Column {
+	c.overflow = OverflowTruncate
+	return c
+}
+
+// Wrap lets the column be shrunk, wrapping its cells on multiple lines, when the table is wider than the terminal.
+// Can be chained with other formatters: Column().JQ(...).Wrap()
+func (c Column) Wrap() Column {
+	c.overflow = OverflowWrap
+	return c
+}
+
+// MaxWidth limits the width of the column when the table is fitted to the terminal.
+// Can be chained with other formatters: Column().JQ(...).Wrap().MaxWidth(60)
+func (c Column) MaxWidth(width int) Column {
+	c.maxWidth = width
+	return c
+}
+
+// SelectColumns returns the columns to show, dropping the ones marked as wide unless wide is set.
+// This allows declaring the default and wide column sets of a command once.
+func SelectColumns(columns []Column, wide bool) []Column {
+	if wide {
+		return columns
+	}
+
+	selected := make([]Column, 0, len(columns))
+
+	for _, col := range columns {
+		if !col.wide {
+			selected = append(selected, col)
+		}
+	}
+
+	return selected
+}
+
 // NewWithColumns creates a new table renderer with columns defined using the fluent API.
 // This provides a more declarative way to define tables with JQ queries or custom formatters.
 // Supports chaining formatters: Column().JQ(...).Fn(...)
@@ -46,8 +95,14 @@ func (c Column) Fn(formatter ColumnFormatter) Column {
 //	    table.NewColumn("READY").JQ(`.status.conditions[] | select(.type=="Ready") | .status // "Unknown"`),
 //	)
 func NewWithColumns[T any](writer io.Writer, columns ...Column) *Renderer[T] {
+	return NewRenderer[T](append(ColumnOptions[T](columns...), WithWriter[T](writer))...)
+}
+
+// ColumnOptions returns the renderer options setting the headers and formatters of the given columns,
+// to be combined with other options, e.g. NewRenderer(append(ColumnOptions(columns...), WithNoHeaders())...).
+func ColumnOptions[T any](columns ...Column) []Option[T] {
 	headers := make([]string, len(columns))
-	options := []Option[T]{WithWriter[T](writer)}
+	options := make([]Option[T], 0, len(columns)+1)
 
 	for i, col := range columns {
 		headers[i] = col.name
@@ -63,9 +118,15 @@ func NewWithColumns[T any](writer io.Writer, columns ...Column) *Renderer[T] {
 			// Multiple formatters, chain them together
 			options = append(options, WithFormatter[T](col.name, ChainFormatters(col.formatters...)))
 		}
-	}
 
-	options = append([]Option[T]{WithHeaders[T](headers...)}, options...)
+		if col.overflow != OverflowNone {
+			options = append(options, WithOverflow[T](col.name, col.overflow))
+		}
+
+		if col.maxWidth > 0 {
+			options = append(options, WithColumnMaxWidth[T](col.name, col.maxWidth))
+		}
+	}
 
-	return NewRenderer[T](options...)
+	return append([]Option[T]{WithHeaders[T](headers...)}, options...)
 }
@@ -0,0 +1,107 @@
+package table
+
+import (
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter/pkg/twwarp"
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
+	"golang.org/x/term"
+)
+
+// Overflow is the policy applied to the cells of a column wider than the width allotted to it.
+type Overflow int
+
+const (
+	// OverflowNone never shrinks the column.
+	OverflowNone Overflow = iota
+	// OverflowTruncate cuts the cells to the column width, ending them with an ellipsis.
+	OverflowTruncate
+	// OverflowWrap wraps the cells on multiple lines at word boundaries.
+	OverflowWrap
+)
+
+const (
+	// ellipsis ends truncated cells.
+	ellipsis = "…"
+	// minColumnWidth is the width below which columns are not shrunk to fit the table width.
+	minColumnWidth = 10
+	// columnOverhead is the width added to each column by the default rendition (one space on each side).
+	columnOverhead = 2
+	// tableOverhead is the width of the left and right borders of the default rendition.
+	tableOverhead = 2
+)
+
+// TruncateFormatter creates a ColumnFormatter that cuts strings longer than the given
+// display width, ending them with an ellipsis. Other values are left unchanged.
+func TruncateFormatter(width int) ColumnFormatter {
+	return func(value any) any {
+		s, ok := value.(string)
+		if !ok {
+			return value
+		}
+
+		return truncate(s, width)
+	}
+}
+
+// WrapFormatter creates a ColumnFormatter that wraps strings longer than the given
+// display width on multiple lines, at word boundaries. Other values are left unchanged.
+func WrapFormatter(width int) ColumnFormatter {
+	return func(value any) any {
+		s, ok := value.(string)
+		if !ok {
+			return value
+		}
+
+		return wrap(s, width)
+	}
+}
+
+// terminalWidth returns the width of the terminal w is attached to, or 0 if it is not a terminal.
+func terminalWidth(w io.Writer) int {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return 0
+	}
+
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+
+	return width
+}
+
+// apply returns the cell fitted to the given width according to the overflow policy.
+func (o Overflow) apply(cell string, width int) string {
+	switch o {
+	case OverflowWrap:
+		return wrap(cell, width)
+	default:
+		return truncate(cell, width)
+	}
+}
+
+func truncate(s string, width int) string {
+	if width <= 0 || twwidth.Width(s) <= width {
+		return s
+	}
+
+	return twwidth.Truncate(s, width-twwidth.Width(ellipsis)) + ellipsis
+}
+
+func wrap(s string, width int) string {
+	if width <= 0 || twwidth.Width(s) <= width {
+		return s
+	}
+
+	lines, _ := twwarp.WrapString(s, width)
+
+	// Words longer than the width are still cut, so that the column never exceeds it.
+	for i, line := range lines {
+		lines[i] = truncate(line, width)
+	}
+
+	return strings.Join(lines, "\n")
+}
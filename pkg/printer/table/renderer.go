@@ -6,11 +6,15 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
 
 	mapstructure "github.com/go-viper/mapstructure/v2"
 	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
 	"github.com/olekukonko/tablewriter/tw"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
 )
 
 // ColumnFormatter is a function that transforms a value for display in a specific column.
@@ -19,11 +23,34 @@ type ColumnFormatter func(value any) any
 // Renderer provides a flexible interface for creating and rendering tables.
 // T is the type of objects that will be appended to the table.
 type Renderer[T any] struct {
-	writer       io.Writer
-	headers      []string
-	formatters   map[string]ColumnFormatter
-	table        *tablewriter.Table
-	tableOptions []tablewriter.Option
+	writer          io.Writer
+	headers         []string
+	formatters      map[string]ColumnFormatter
+	table           *tablewriter.Table
+	tableOptions    []tablewriter.Option
+	noHeaders       bool
+	color           ColorMode
+	colorize        bool
+	maxWidth        int
+	maxWidthSet     bool
+	overflows       map[string]Overflow
+	maxColumnWidths map[string]int
+	fixedWidths     map[string]int
+	streaming       bool
+	sampleSize      int
+	widths          []int
+	started         bool
+	rowWriter       RowWriter
+	filter          string
+	sortKeys        []SortKey
+	rows            []renderedRow
+}
+
+// renderedRow is a row appended to the table, kept until rendering so that rows can be sorted.
+// In streaming mode, rows are only kept until they are written.
+type renderedRow struct {
+	value any
+	cells []any
 }
 
 // NewRenderer creates a new table renderer with the given tableOptions.
@@ -38,6 +65,13 @@ func NewRenderer[T any](opts ...Option[T]) *Renderer[T] {
 		opt.ApplyTo(r)
 	}
 
+	r.colorize = r.color.Enabled(r.writer)
+
+	// Piped output is left untouched unless a width is explicitly set.
+	if !r.maxWidthSet {
+		r.maxWidth = terminalWidth(r.writer)
+	}
+
 	r.table = tablewriter.NewTable(r.writer)
 
 	if len(r.tableOptions) == 0 {
@@ -54,16 +88,27 @@ func NewRenderer[T any](opts ...Option[T]) *Renderer[T] {
 		r.table = r.table.Options(r.tableOptions...)
 	}
 
-	if len(r.headers) > 0 {
+	if len(r.headers) > 0 && !r.noHeaders {
 		r.table.Header(r.headers)
 	}
 
 	return r
 }
 
-// Append adds a single row to the table.
+// Append adds a single row to the table, unless it is excluded by the filter.
 // Accepts either []any (legacy) or a struct (auto-extracted via mapstructure).
 func (r *Renderer[T]) Append(value T) error {
+	if r.filter != "" {
+		result, err := jq.Query(value, r.filter)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate filter: %w", err)
+		}
+
+		if result == nil || result == false {
+			return nil
+		}
+	}
+
 	// Check if all headers have formatters
 	allHaveFormatters := true
 	for _, header := range r.headers {
@@ -105,8 +150,11 @@ func (r *Renderer[T]) Append(value T) error {
 		row = append(row, v)
 	}
 
-	if err := r.table.Append(row); err != nil {
-		return fmt.Errorf("failed to append row to table: %w", err)
+	r.rows = append(r.rows, renderedRow{value: value, cells: row})
+
+	// In streaming mode rows are written as they are appended, once the sample is complete.
+	if r.streaming && (r.started || len(r.rows) >= r.sampleSize) {
+		return r.flush()
 	}
 
 	return nil
@@ -183,8 +231,33 @@ func (r *Renderer[T]) AppendAll(rows []T) error {
 	return nil
 }
 
-// Render outputs the table to the configured writer.
+// Render outputs the table to the configured writer, with the rows ordered by the sort keys if any.
+// In streaming mode, or with a row writer, it writes the rows not written yet, see WithStreaming and WithRowWriter.
 func (r *Renderer[T]) Render() error {
+	if r.streaming || r.rowWriter != nil {
+		return r.flush()
+	}
+
+	if err := r.sortRows(); err != nil {
+		return err
+	}
+
+	rows := r.styledRows()
+
+	// Columns are only fitted to a maximum width, so that piped output is left untouched.
+	if r.maxWidth > 0 {
+		widths := r.columnWidths(rows, tableOverhead+columnOverhead*len(r.headers))
+		for _, cells := range rows {
+			r.fitCells(cells, widths, true)
+		}
+	}
+
+	for _, cells := range rows {
+		if err := r.table.Append(cells); err != nil {
+			return fmt.Errorf("failed to append row to table: %w", err)
+		}
+	}
+
 	if err := r.table.Render(); err != nil {
 		return fmt.Errorf("failed to render table: %w", err)
 	}
@@ -195,10 +268,203 @@ func (r *Renderer[T]) Render() error {
 // SetHeaders updates the table headers (useful for dynamic header configuration).
 func (r *Renderer[T]) SetHeaders(headers ...string) {
 	r.headers = headers
-	r.table.Header(headers)
+
+	if !r.noHeaders {
+		r.table.Header(headers)
+	}
 }
 
 // GetHeaders returns the current headers.
 func (r *Renderer[T]) GetHeaders() []string {
 	return r.headers
 }
+
+// sortRows orders the rows by the sort keys, keeping the insertion order of equal rows.
+// Keys matching a column name compare the formatted cells, by their sort value for Sortable
+// cells such as ages, other keys are jq expressions evaluated against the row values.
+func (r *Renderer[T]) sortRows() error {
+	if len(r.sortKeys) == 0 {
+		return nil
+	}
+
+	keys := make([][]any, len(r.rows))
+
+	for i, row := range r.rows {
+		keys[i] = make([]any, len(r.sortKeys))
+
+		for k, sortKey := range r.sortKeys {
+			if column := r.columnIndex(sortKey.Key); column >= 0 {
+				keys[i][k] = row.cells[column]
+
+				continue
+			}
+
+			value, err := jq.Query(row.value, sortKey.Key)
+			if err != nil {
+				return fmt.Errorf("failed to evaluate sort key %q: %w", sortKey.Key, err)
+			}
+
+			keys[i][k] = value
+		}
+	}
+
+	indexes := make([]int, len(r.rows))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	slices.SortStableFunc(indexes, func(a int, b int) int {
+		for k, sortKey := range r.sortKeys {
+			result := compareValues(keys[a][k], keys[b][k])
+			if sortKey.Descending {
+				result = -result
+			}
+
+			if result != 0 {
+				return result
+			}
+		}
+
+		return 0
+	})
+
+	sorted := make([]renderedRow, len(r.rows))
+	for i, index := range indexes {
+		sorted[i] = r.rows[index]
+	}
+
+	r.rows = sorted
+
+	return nil
+}
+
+// columnIndex returns the index of the column with the given name (case-insensitive), or -1.
+func (r *Renderer[T]) columnIndex(name string) int {
+	for i, header := range r.headers {
+		if strings.EqualFold(header, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// columnWidths returns the display width of each column for the given rows, given the width
+// taken by borders and separators. If the renderer has a maximum width, columns are capped to
+// their maximum width and the widest columns having an overflow policy are shrunk until the
+// table fits, but not below minColumnWidth.
+func (r *Renderer[T]) columnWidths(rows [][]any, overhead int) []int {
+	widths := make([]int, len(r.headers))
+
+	for c, header := range r.headers {
+		if width, ok := r.fixedWidths[strings.ToUpper(header)]; ok {
+			widths[c] = width
+
+			continue
+		}
+
+		widths[c] = twwidth.Width(header)
+		for _, cells := range rows {
+			widths[c] = max(widths[c], cellWidth(cells[c]))
+		}
+	}
+
+	if r.maxWidth <= 0 {
+		return widths
+	}
+
+	for c, header := range r.headers {
+		if limit, ok := r.maxColumnWidths[strings.ToUpper(header)]; ok && limit > 0 && widths[c] > limit {
+			widths[c] = limit
+		}
+	}
+
+	excess := overhead - r.maxWidth
+	for _, width := range widths {
+		excess += width
+	}
+
+	for ; excess > 0; excess-- {
+		widest := -1
+
+		for c, width := range widths {
+			if r.overflow(c) == OverflowNone || width <= max(minColumnWidth, twwidth.Width(r.headers[c])) {
+				continue
+			}
+
+			if widest < 0 || width > widths[widest] {
+				widest = c
+			}
+		}
+
+		if widest < 0 {
+			break
+		}
+
+		widths[widest]--
+	}
+
+	return widths
+}
+
+// fitCells applies the overflow policy of each column to the string cells wider than the column,
+// wrapped cells being truncated instead if wrap is not set. Cells of columns without an overflow
+// policy or a maximum width are left as is.
+func (r *Renderer[T]) fitCells(cells []any, widths []int, wrap bool) {
+	for c, width := range widths {
+		s, ok := cells[c].(string)
+		if !ok || cellWidth(s) <= width {
+			continue
+		}
+
+		overflow := r.overflow(c)
+		if overflow == OverflowNone && r.maxColumnWidths[strings.ToUpper(r.headers[c])] <= 0 {
+			continue
+		}
+
+		if overflow == OverflowWrap && !wrap {
+			overflow = OverflowTruncate
+		}
+
+		cells[c] = overflow.apply(s, width)
+	}
+}
+
+// overflow returns the overflow policy of the column at the given index.
+func (r *Renderer[T]) overflow(column int) Overflow {
+	return r.overflows[strings.ToUpper(r.headers[column])]
+}
+
+// styledRows returns the cells of the buffered rows, with styled cells rendered
+// and the other cells implementing fmt.Stringer converted to their string form.
+func (r *Renderer[T]) styledRows() [][]any {
+	rows := make([][]any, len(r.rows))
+
+	for i, row := range r.rows {
+		rows[i] = make([]any, len(row.cells))
+
+		for c, cell := range row.cells {
+			switch v := cell.(type) {
+			case Styled:
+				cell = v.Render(r.colorize)
+			case fmt.Stringer:
+				cell = v.String()
+			}
+
+			rows[i][c] = cell
+		}
+	}
+
+	return rows
+}
+
+// cellWidth returns the display width of the widest line of a cell.
+func cellWidth(cell any) int {
+	width := 0
+
+	for line := range strings.Lines(fmt.Sprint(cell)) {
+		width = max(width, twwidth.Width(strings.TrimSuffix(line, "\n")))
+	}
+
+	return width
+}
@@ -3,8 +3,10 @@ package table
 import (
 	"io"
 	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	"github.com/lburgazzoli/odh-cli/pkg/util"
 	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
@@ -38,6 +40,98 @@ func WithFormatter[T any](columnName string, formatter ColumnFormatter) Option[T
 	})
 }
 
+// WithNoHeaders omits the header row when rendering the table.
+func WithNoHeaders[T any]() Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.noHeaders = true
+	})
+}
+
+// WithColor sets whether styled cells are colorized, see ColorMode.
+func WithColor[T any](mode ColorMode) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.color = mode
+	})
+}
+
+// WithMaxWidth sets the maximum width of the table, overriding the width of the terminal
+// the writer is attached to. Columns are only fitted to a maximum width, see WithOverflow.
+func WithMaxWidth[T any](width int) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.maxWidth = width
+		r.maxWidthSet = true
+	})
+}
+
+// WithOverflow sets how the cells of a column are fitted when the table is wider than its maximum width.
+func WithOverflow[T any](columnName string, overflow Overflow) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		if r.overflows == nil {
+			r.overflows = make(map[string]Overflow)
+		}
+
+		r.overflows[strings.ToUpper(columnName)] = overflow
+	})
+}
+
+// WithColumnMaxWidth sets the maximum width of a column, applied along with the maximum width of the table.
+// Cells wider than it are truncated, unless the column overflow policy is set to OverflowWrap.
+func WithColumnMaxWidth[T any](columnName string, width int) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		if r.maxColumnWidths == nil {
+			r.maxColumnWidths = make(map[string]int)
+		}
+
+		r.maxColumnWidths[strings.ToUpper(columnName)] = width
+	})
+}
+
+// WithStreaming writes the rows as they are appended rather than when rendering the table,
+// without borders and with columns separated by spaces like kubectl does when watching resources.
+// The column widths are computed from the first sampleSize rows, which are buffered, sorted and
+// written together; later rows are written right away with the same widths. Render writes the
+// rows buffered so far, e.g. when fewer rows than the sample size are available.
+func WithStreaming[T any](sampleSize int) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.streaming = true
+		r.sampleSize = sampleSize
+	})
+}
+
+// WithRowWriter writes the headers and rows with the given RowWriter rather than as a table, e.g. as CSV.
+// Rows are written when rendering the table, or as they are appended in streaming mode.
+func WithRowWriter[T any](writer RowWriter) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.rowWriter = writer
+	})
+}
+
+// WithColumnWidth sets the width of a column in streaming mode, instead of computing it from the sample.
+func WithColumnWidth[T any](columnName string, width int) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		if r.fixedWidths == nil {
+			r.fixedWidths = make(map[string]int)
+		}
+
+		r.fixedWidths[strings.ToUpper(columnName)] = width
+	})
+}
+
+// WithSortBy orders the rows by the given keys when rendering the table, see ParseSortKeys.
+func WithSortBy[T any](keys ...SortKey) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.sortKeys = append(r.sortKeys, keys...)
+	})
+}
+
+// WithFilter only keeps the rows for which the given jq expression, evaluated
+// against the row value, is neither false nor null.
+func WithFilter[T any](expression string) Option[T] {
+	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
+		r.filter = expression
+	})
+}
+
 // WithTableOptions sets the underlying tablewriter options.
 func WithTableOptions[T any](values ...tablewriter.Option) Option[T] {
 	return util.FunctionalOption[Renderer[T]](func(r *Renderer[T]) {
@@ -81,3 +175,41 @@ func ChainFormatters(formatters ...ColumnFormatter) ColumnFormatter {
 		return result
 	}
 }
+
+// Age is a cell value printed as a human-readable age (e.g. "5m", "3d"), the same way kubectl
+// renders the AGE column, and sorted by the timestamp it is computed from, so that the oldest
+// come first like with kubectl --sort-by .metadata.creationTimestamp.
+type Age struct {
+	// Timestamp is the time the age is counted from.
+	Timestamp time.Time
+	// Duration is the time elapsed since Timestamp when the cell was computed.
+	Duration time.Duration
+}
+
+// String implements fmt.Stringer.
+func (a Age) String() string {
+	return duration.HumanDuration(a.Duration)
+}
+
+// SortValue implements Sortable.
+func (a Age) SortValue() any {
+	return a.Timestamp.UnixNano()
+}
+
+// AgeFormatter creates a ColumnFormatter that converts an RFC3339 timestamp into an Age.
+// Values that are not valid timestamps are rendered as "<unknown>".
+func AgeFormatter() ColumnFormatter {
+	return func(value any) any {
+		s, ok := value.(string)
+		if !ok || s == "" {
+			return "<unknown>"
+		}
+
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return "<unknown>"
+		}
+
+		return Age{Timestamp: ts, Duration: time.Since(ts)}
+	}
+}
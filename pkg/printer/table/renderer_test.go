@@ -2,8 +2,12 @@ package table_test
 
 import (
 	"bytes"
+	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/olekukonko/tablewriter/pkg/twwidth"
 
 	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
 
@@ -236,3 +240,390 @@ func TestRendererCaseInsensitiveMatching(t *testing.T) {
 	g.Expect(output).Should(ContainSubstring("Alice"))
 	g.Expect(output).Should(ContainSubstring("30"))
 }
+
+// renderAges renders a table of creation timestamps formatted as ages, sorted by the given key.
+func renderAges(g Gomega, key table.SortKey) string {
+	now := time.Now()
+
+	var buf bytes.Buffer
+	renderer := table.NewRenderer[[]any](
+		table.WithWriter[[]any](&buf),
+		table.WithHeaders[[]any]("Name", "Age"),
+		table.WithFormatter[[]any]("Age", table.AgeFormatter()),
+		table.WithSortBy[[]any](key),
+	)
+
+	for name, age := range map[string]time.Duration{
+		"sixteen-days":  16 * 24 * time.Hour,
+		"three-hours":   3*time.Hour + 48*time.Minute,
+		"fifteen-days":  15 * 24 * time.Hour,
+		"forty-minutes": 40 * time.Minute,
+	} {
+		g.Expect(renderer.Append([]any{name, now.Add(-age).Format(time.RFC3339)})).Should(Succeed())
+	}
+
+	g.Expect(renderer.Render()).Should(Succeed())
+
+	return buf.String()
+}
+
+func newPeopleRenderer(buf *bytes.Buffer, opts ...table.Option[testPerson]) *table.Renderer[testPerson] {
+	options := []table.Option[testPerson]{
+		table.WithWriter[testPerson](buf),
+		table.WithHeaders[testPerson]("Name", "Age", "Status"),
+	}
+
+	return table.NewRenderer[testPerson](append(options, opts...)...)
+}
+
+func renderPeople(g Gomega, renderer *table.Renderer[testPerson]) {
+	people := []testPerson{
+		{Name: "Charlie", Age: 35, Status: "active"},
+		{Name: "Alice", Age: 30, Status: "inactive"},
+		{Name: "Bob", Age: 25, Status: "active"},
+	}
+
+	g.Expect(renderer.AppendAll(people)).Should(Succeed())
+	g.Expect(renderer.Render()).Should(Succeed())
+}
+
+func TestRendererSortBy(t *testing.T) {
+	t.Run("should sort by column", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderPeople(g, newPeopleRenderer(&buf, table.WithSortBy[testPerson](table.SortKey{Key: "name"})))
+
+		g.Expect(buf.String()).Should(MatchRegexp(`(?s)Alice.*Bob.*Charlie`))
+	})
+
+	t.Run("should sort numbers numerically and in descending order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		keys, err := table.ParseSortKeys("Age:desc")
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		var buf bytes.Buffer
+		renderPeople(g, newPeopleRenderer(&buf, table.WithSortBy[testPerson](keys...)))
+
+		g.Expect(buf.String()).Should(MatchRegexp(`(?s)Charlie.*Alice.*Bob`))
+	})
+
+	t.Run("should sort by multiple keys and jq expressions", func(t *testing.T) {
+		g := NewWithT(t)
+
+		keys, err := table.ParseSortKeys(`Status, .Name | ascii_downcase:desc`)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(keys).Should(Equal([]table.SortKey{
+			{Key: "Status"},
+			{Key: ".Name | ascii_downcase", Descending: true},
+		}))
+
+		var buf bytes.Buffer
+		renderPeople(g, newPeopleRenderer(&buf, table.WithSortBy[testPerson](keys...)))
+
+		g.Expect(buf.String()).Should(MatchRegexp(`(?s)Charlie.*Bob.*Alice`))
+	})
+
+	t.Run("should sort ages by timestamp, oldest first", func(t *testing.T) {
+		g := NewWithT(t)
+
+		output := renderAges(g, table.SortKey{Key: "AGE"})
+		g.Expect(output).Should(MatchRegexp(`(?s)sixteen-days\s+16d.*fifteen-days\s+15d.*three-hours\s+3h48m.*forty-minutes\s+40m`))
+	})
+
+	t.Run("should sort ages by timestamp in descending order, newest first", func(t *testing.T) {
+		g := NewWithT(t)
+
+		output := renderAges(g, table.SortKey{Key: "AGE", Descending: true})
+		g.Expect(output).Should(MatchRegexp(`(?s)forty-minutes\s+40m.*three-hours\s+3h48m.*fifteen-days\s+15d.*sixteen-days\s+16d`))
+	})
+
+	t.Run("should reject invalid sort keys", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, spec := range []string{"", ":desc", ".name[", "1 +"} {
+			_, err := table.ParseSortKeys(spec)
+			g.Expect(err).Should(HaveOccurred(), spec)
+		}
+	})
+}
+
+func TestRendererFilter(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	renderPeople(g, newPeopleRenderer(&buf, table.WithFilter[testPerson](`.Status == "active"`)))
+
+	output := buf.String()
+	g.Expect(output).Should(ContainSubstring("Charlie"))
+	g.Expect(output).Should(ContainSubstring("Bob"))
+	g.Expect(output).ShouldNot(ContainSubstring("Alice"))
+}
+
+func TestRendererNoHeaders(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	renderPeople(g, newPeopleRenderer(&buf, table.WithNoHeaders[testPerson]()))
+
+	output := buf.String()
+	g.Expect(output).Should(ContainSubstring("Alice"))
+	g.Expect(output).ShouldNot(ContainSubstring("NAME"))
+}
+
+func TestRendererColor(t *testing.T) {
+	newStatusRenderer := func(buf *bytes.Buffer, mode table.ColorMode) *table.Renderer[testPerson] {
+		return table.NewRenderer[testPerson](
+			table.WithWriter[testPerson](buf),
+			table.WithColor[testPerson](mode),
+			table.WithHeaders[testPerson]("Name", "Status"),
+			table.WithFormatter[testPerson]("Name", table.JQFormatter(".Name")),
+			table.WithFormatter[testPerson]("Status", table.ChainFormatters(
+				table.JQFormatter(".Status"),
+				table.StatusFormatter(),
+			)),
+		)
+	}
+
+	people := []testPerson{
+		{Name: "Alice", Status: "True"},
+		{Name: "Bob", Status: "False"},
+		{Name: "Charlie", Status: "pending"},
+	}
+
+	t.Run("should decorate statuses with icons and colors", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := newStatusRenderer(&buf, table.ColorAlways)
+		g.Expect(renderer.AppendAll(people)).Should(Succeed())
+		g.Expect(renderer.Render()).Should(Succeed())
+
+		output := buf.String()
+		g.Expect(output).Should(ContainSubstring("\x1b[32m✅ True\x1b[0m"))
+		g.Expect(output).Should(ContainSubstring("\x1b[31m❌ False\x1b[0m"))
+		g.Expect(output).Should(ContainSubstring("pending"))
+	})
+
+	t.Run("should only add icons when colors are disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for _, mode := range []table.ColorMode{table.ColorNever, table.ColorAuto} {
+			var buf bytes.Buffer
+			renderer := newStatusRenderer(&buf, mode)
+			g.Expect(renderer.AppendAll(people)).Should(Succeed())
+			g.Expect(renderer.Render()).Should(Succeed())
+
+			output := buf.String()
+			g.Expect(output).Should(ContainSubstring("✅ True"))
+			g.Expect(output).ShouldNot(ContainSubstring("\x1b["))
+		}
+	})
+
+	t.Run("should only colorize terminals without NO_COLOR in auto mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		g.Expect(table.ColorAuto.Enabled(&buf)).Should(BeFalse())
+		g.Expect(table.ColorAlways.Enabled(&buf)).Should(BeTrue())
+
+		t.Setenv("NO_COLOR", "1")
+		g.Expect(table.ColorAuto.Enabled(os.Stdout)).Should(BeFalse())
+	})
+
+	t.Run("should validate color modes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var mode table.ColorMode
+		g.Expect(mode.Set("always")).Should(Succeed())
+		g.Expect(mode).Should(Equal(table.ColorAlways))
+		g.Expect(mode.Set("sometimes")).ShouldNot(Succeed())
+	})
+}
+
+func TestStatusFormatter(t *testing.T) {
+	g := NewWithT(t)
+
+	formatter := table.StatusFormatter()
+
+	g.Expect(formatter("OK")).Should(Equal(table.Styled{Text: "OK", Icon: "✅", Color: table.ColorGreen}))
+	g.Expect(formatter("WARNING")).Should(Equal(table.Styled{Text: "WARNING", Icon: "⚠️", Color: table.ColorYellow}))
+	g.Expect(formatter("Unknown")).Should(Equal(table.Styled{Text: "Unknown", Icon: "⚠️", Color: table.ColorYellow}))
+	g.Expect(formatter(false)).Should(Equal(table.Styled{Text: "false", Icon: "❌", Color: table.ColorRed}))
+	g.Expect(formatter("-")).Should(Equal("-"))
+}
+
+func TestRendererOverflow(t *testing.T) {
+	const message = "the quick brown fox jumps over the lazy dog"
+
+	newMessageRenderer := func(buf *bytes.Buffer, opts ...table.Option[[]any]) *table.Renderer[[]any] {
+		options := []table.Option[[]any]{
+			table.WithWriter[[]any](buf),
+			table.WithHeaders[[]any]("Name", "Message"),
+		}
+
+		return table.NewRenderer[[]any](append(options, opts...)...)
+	}
+
+	render := func(g Gomega, renderer *table.Renderer[[]any]) {
+		g.Expect(renderer.Append([]any{"alice", message})).Should(Succeed())
+		g.Expect(renderer.Render()).Should(Succeed())
+	}
+
+	t.Run("should leave output untouched without a maximum width", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		render(g, newMessageRenderer(&buf, table.WithOverflow[[]any]("Message", table.OverflowTruncate)))
+
+		g.Expect(buf.String()).Should(ContainSubstring(message))
+	})
+
+	t.Run("should truncate columns to fit the maximum width", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		render(g, newMessageRenderer(&buf,
+			table.WithMaxWidth[[]any](40),
+			table.WithOverflow[[]any]("Message", table.OverflowTruncate),
+		))
+
+		g.Expect(buf.String()).Should(ContainSubstring("the quick brown fox jumps ov…"))
+
+		for line := range strings.Lines(buf.String()) {
+			g.Expect(twwidth.Width(strings.TrimSuffix(line, "\n"))).Should(BeNumerically("<=", 40))
+		}
+	})
+
+	t.Run("should wrap columns to fit the maximum width", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		render(g, newMessageRenderer(&buf,
+			table.WithMaxWidth[[]any](40),
+			table.WithOverflow[[]any]("Message", table.OverflowWrap),
+		))
+
+		output := buf.String()
+		g.Expect(output).Should(ContainSubstring("the quick brown fox"))
+		g.Expect(output).Should(ContainSubstring("lazy dog"))
+		g.Expect(output).ShouldNot(ContainSubstring("…"))
+	})
+
+	t.Run("should apply column maximum widths", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		render(g, newMessageRenderer(&buf,
+			table.WithMaxWidth[[]any](200),
+			table.WithColumnMaxWidth[[]any]("Message", 12),
+		))
+
+		g.Expect(buf.String()).Should(ContainSubstring("the quick b…"))
+	})
+
+	t.Run("should not shrink columns without an overflow policy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		render(g, newMessageRenderer(&buf, table.WithMaxWidth[[]any](40)))
+
+		g.Expect(buf.String()).Should(ContainSubstring(message))
+	})
+}
+
+func TestOverflowFormatters(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(table.TruncateFormatter(8)("truncated value")).Should(Equal("truncat…"))
+	g.Expect(table.TruncateFormatter(8)("short")).Should(Equal("short"))
+	g.Expect(table.TruncateFormatter(8)(42)).Should(Equal(42))
+	g.Expect(table.WrapFormatter(10)("wrapped on two lines")).Should(Equal("wrapped on\ntwo lines"))
+	g.Expect(table.WrapFormatter(4)("unbreakable")).Should(Equal("unb…"))
+}
+
+func TestRendererStreaming(t *testing.T) {
+	people := []testPerson{
+		{Name: "Alice", Age: 30, Status: "active"},
+		{Name: "Bob", Age: 25, Status: "inactive"},
+	}
+
+	newStreamRenderer := func(buf *bytes.Buffer, opts ...table.Option[testPerson]) *table.Renderer[testPerson] {
+		return newPeopleRenderer(buf, append([]table.Option[testPerson]{table.WithStreaming[testPerson](len(people))}, opts...)...)
+	}
+
+	t.Run("should compute column widths from the sample", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := newStreamRenderer(&buf)
+
+		g.Expect(renderer.Append(people[0])).Should(Succeed())
+		g.Expect(buf.String()).Should(BeEmpty())
+
+		g.Expect(renderer.Append(people[1])).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(
+			"NAME    AGE   STATUS\n" +
+				"Alice   30    active\n" +
+				"Bob     25    inactive\n",
+		))
+	})
+
+	t.Run("should write rows appended after the sample right away", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := newStreamRenderer(&buf, table.WithNoHeaders[testPerson]())
+
+		g.Expect(renderer.AppendAll(people)).Should(Succeed())
+		buf.Reset()
+
+		g.Expect(renderer.Append(testPerson{Name: "Charlie", Age: 35, Status: "active"})).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal("Charlie   35    active\n"))
+	})
+
+	t.Run("should sort the sample and flush it on render", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := table.NewRenderer[testPerson](
+			table.WithWriter[testPerson](&buf),
+			table.WithHeaders[testPerson]("Name", "Age"),
+			table.WithStreaming[testPerson](10),
+			table.WithSortBy[testPerson](table.SortKey{Key: "Age"}),
+		)
+
+		g.Expect(renderer.AppendAll(people)).Should(Succeed())
+		g.Expect(buf.String()).Should(BeEmpty())
+
+		g.Expect(renderer.Render()).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(
+			"NAME    AGE\n" +
+				"Bob     25\n" +
+				"Alice   30\n",
+		))
+	})
+
+	t.Run("should use fixed column widths and truncate overflowing columns", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var buf bytes.Buffer
+		renderer := table.NewRenderer[[]any](
+			table.WithWriter[[]any](&buf),
+			table.WithHeaders[[]any]("Name", "Message"),
+			table.WithStreaming[[]any](0),
+			table.WithColumnWidth[[]any]("Name", 8),
+			table.WithMaxWidth[[]any](30),
+			table.WithOverflow[[]any]("Message", table.OverflowWrap),
+		)
+
+		g.Expect(renderer.Append([]any{"alice", "the quick brown fox jumps over the lazy dog"})).Should(Succeed())
+		g.Expect(renderer.Append([]any{"bartholomew", "ok"})).Should(Succeed())
+		g.Expect(buf.String()).Should(Equal(
+			"NAME       MESSAGE\n" +
+				"alice      the quick brown fo…\n" +
+				"bartholomew   ok\n",
+		))
+	})
+}
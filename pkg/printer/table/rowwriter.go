@@ -0,0 +1,128 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RowWriter writes the headers and rows of a table in a format other than a rendered table.
+// Cells are the values returned by the column formatters, e.g. Styled values.
+type RowWriter interface {
+	WriteHeader(headers []any) error
+	WriteRow(cells []any) error
+	Flush() error
+}
+
+// CSVWriter writes rows as delimiter separated values, quoting cells as needed.
+// Styled cells are written as their plain text.
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVWriter returns a CSVWriter writing to w, with cells separated by comma, e.g. ',' or '\t'.
+func NewCSVWriter(w io.Writer, comma rune) *CSVWriter {
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+
+	return &CSVWriter{writer: writer}
+}
+
+// WriteHeader implements RowWriter.
+func (c *CSVWriter) WriteHeader(headers []any) error {
+	return c.WriteRow(headers)
+}
+
+// WriteRow implements RowWriter.
+func (c *CSVWriter) WriteRow(cells []any) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = cellText(cell)
+	}
+
+	if err := c.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write record: %w", err)
+	}
+
+	return nil
+}
+
+// Flush implements RowWriter.
+func (c *CSVWriter) Flush() error {
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+// MarkdownWriter writes rows as a GitHub flavored Markdown table.
+// Styled cells are written with their icon but without colors.
+type MarkdownWriter struct {
+	writer io.Writer
+}
+
+// NewMarkdownWriter returns a MarkdownWriter writing to w.
+func NewMarkdownWriter(w io.Writer) *MarkdownWriter {
+	return &MarkdownWriter{writer: w}
+}
+
+// WriteHeader implements RowWriter. The header separator line is written along with the headers.
+func (m *MarkdownWriter) WriteHeader(headers []any) error {
+	if err := m.WriteRow(headers); err != nil {
+		return err
+	}
+
+	separators := make([]any, len(headers))
+	for i := range headers {
+		separators[i] = "---"
+	}
+
+	return m.WriteRow(separators)
+}
+
+// WriteRow implements RowWriter.
+func (m *MarkdownWriter) WriteRow(cells []any) error {
+	var line strings.Builder
+
+	line.WriteString("|")
+
+	for _, cell := range cells {
+		text := cellText(cell)
+		if styled, ok := cell.(Styled); ok {
+			text = styled.Render(false)
+		}
+
+		line.WriteString(" ")
+		line.WriteString(escapeMarkdown(text))
+		line.WriteString(" |")
+	}
+
+	if _, err := fmt.Fprintln(m.writer, line.String()); err != nil {
+		return fmt.Errorf("failed to write row: %w", err)
+	}
+
+	return nil
+}
+
+// Flush implements RowWriter.
+func (m *MarkdownWriter) Flush() error {
+	return nil
+}
+
+// cellText returns the plain text of a cell, nil cells being empty.
+func cellText(cell any) string {
+	if cell == nil {
+		return ""
+	}
+
+	return fmt.Sprint(cell)
+}
+
+// escapeMarkdown escapes the characters that would break a Markdown table cell.
+func escapeMarkdown(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
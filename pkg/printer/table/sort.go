@@ -0,0 +1,120 @@
+package table
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lburgazzoli/odh-cli/pkg/util/jq"
+)
+
+var columnNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9 _-]*$`)
+
+// SortKey orders the rows of a table by a column or by a jq expression.
+type SortKey struct {
+	// Key is either the name of a column (case-insensitive) or a jq
+	// expression evaluated against the row value.
+	Key string
+	// Descending reverses the order.
+	Descending bool
+}
+
+// ParseSortKeys parses a comma separated list of sort keys, each one being a column
+// name or a jq expression optionally followed by ":asc" or ":desc", e.g. "READY:desc,.metadata.name".
+// Rows comparing equal on a key are ordered by the next one.
+func ParseSortKeys(spec string) ([]SortKey, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, errors.New("no sort key given")
+	}
+
+	parts := jq.Split(spec, ',')
+	keys := make([]SortKey, 0, len(parts))
+
+	for _, part := range parts {
+		key := SortKey{Key: strings.TrimSpace(part)}
+
+		switch {
+		case strings.HasSuffix(key.Key, ":desc"):
+			key.Key = strings.TrimSpace(strings.TrimSuffix(key.Key, ":desc"))
+			key.Descending = true
+		case strings.HasSuffix(key.Key, ":asc"):
+			key.Key = strings.TrimSpace(strings.TrimSuffix(key.Key, ":asc"))
+		}
+
+		if key.Key == "" {
+			return nil, fmt.Errorf("invalid sort key %q", part)
+		}
+
+		// Column names are only known by the renderer, anything else must be a valid expression.
+		if !columnNamePattern.MatchString(key.Key) {
+			if _, err := jq.Compile(key.Key, nil); err != nil {
+				return nil, fmt.Errorf("invalid sort key %q: %w", key.Key, err)
+			}
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// Sortable is implemented by cell values that are not ordered by their string form, e.g. Age.
+type Sortable interface {
+	// SortValue returns the value the cell is ordered by.
+	SortValue() any
+}
+
+// compareValues orders two sort values: missing values first, then numbers
+// numerically and anything else by its string form. Sortable values are
+// compared by their sort value.
+func compareValues(a any, b any) int {
+	if s, ok := a.(Sortable); ok {
+		a = s.SortValue()
+	}
+
+	if s, ok := b.(Sortable); ok {
+		b = s.SortValue()
+	}
+
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	na, aIsNumber := toNumber(a)
+	nb, bIsNumber := toNumber(b)
+
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(na, nb)
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,113 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// streamSeparator separates the columns of streamed rows, the same way kubectl does.
+const streamSeparator = "   "
+
+// flush writes the buffered rows of a streaming renderer, or of a renderer having a row writer.
+// The first time it is called, the buffered rows are sorted by the sort keys if any, the
+// column widths are computed from them and the headers are written. Rows appended later
+// are written with the same widths.
+func (r *Renderer[T]) flush() error {
+	if !r.started {
+		if err := r.sortRows(); err != nil {
+			return err
+		}
+	}
+
+	if r.rowWriter != nil {
+		return r.flushRowWriter()
+	}
+
+	rows := r.styledRows()
+
+	if !r.started {
+		r.started = true
+		r.widths = r.columnWidths(rows, len(streamSeparator)*(len(r.headers)-1))
+
+		if !r.noHeaders {
+			if err := r.writeLine(r.upperHeaders()); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, cells := range rows {
+		r.fitCells(cells, r.widths, false)
+
+		if err := r.writeLine(cells); err != nil {
+			return err
+		}
+	}
+
+	r.rows = r.rows[:0]
+
+	return nil
+}
+
+// flushRowWriter writes the headers, the first time it is called, and the buffered rows to the row writer.
+func (r *Renderer[T]) flushRowWriter() error {
+	if !r.started {
+		r.started = true
+
+		if !r.noHeaders {
+			if err := r.rowWriter.WriteHeader(r.upperHeaders()); err != nil {
+				return fmt.Errorf("failed to write headers: %w", err)
+			}
+		}
+	}
+
+	for _, row := range r.rows {
+		if err := r.rowWriter.WriteRow(row.cells); err != nil {
+			return fmt.Errorf("failed to write row: %w", err)
+		}
+	}
+
+	r.rows = r.rows[:0]
+
+	if err := r.rowWriter.Flush(); err != nil {
+		return fmt.Errorf("failed to write rows: %w", err)
+	}
+
+	return nil
+}
+
+// upperHeaders returns the headers as printed, in upper case like tablewriter does.
+func (r *Renderer[T]) upperHeaders() []any {
+	headers := make([]any, len(r.headers))
+	for i, header := range r.headers {
+		headers[i] = strings.ToUpper(header)
+	}
+
+	return headers
+}
+
+// writeLine writes the given cells padded to the column widths. Cells wider than their
+// column are written as is, shifting the following cells of the line.
+func (r *Renderer[T]) writeLine(cells []any) error {
+	var line strings.Builder
+
+	for c, cell := range cells {
+		text := cellText(cell)
+
+		if c > 0 {
+			line.WriteString(streamSeparator)
+		}
+
+		line.WriteString(text)
+
+		if c < len(cells)-1 {
+			line.WriteString(strings.Repeat(" ", max(0, r.widths[c]-cellWidth(text))))
+		}
+	}
+
+	if _, err := fmt.Fprintln(r.writer, strings.TrimRight(line.String(), " ")); err != nil {
+		return fmt.Errorf("failed to write row: %w", err)
+	}
+
+	return nil
+}
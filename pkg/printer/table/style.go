@@ -0,0 +1,122 @@
+package table
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ColorMode controls whether styled cells are colorized.
+type ColorMode string
+
+const (
+	// ColorAuto colorizes cells when writing to a terminal, unless the NO_COLOR environment variable is set.
+	ColorAuto ColorMode = "auto"
+	// ColorAlways always colorizes cells.
+	ColorAlways ColorMode = "always"
+	// ColorNever never colorizes cells.
+	ColorNever ColorMode = "never"
+)
+
+// String implements pflag.Value.
+func (m *ColorMode) String() string {
+	return string(*m)
+}
+
+// Set implements pflag.Value.
+func (m *ColorMode) Set(value string) error {
+	switch mode := ColorMode(value); mode {
+	case ColorAuto, ColorAlways, ColorNever:
+		*m = mode
+
+		return nil
+	default:
+		return fmt.Errorf("invalid color mode %q: must be one of %s, %s, %s", value, ColorAuto, ColorAlways, ColorNever)
+	}
+}
+
+// Type implements pflag.Value.
+func (m *ColorMode) Type() string {
+	return "string"
+}
+
+// Enabled reports whether output written to w should be colorized.
+// An empty mode behaves as ColorAuto; see https://no-color.org for NO_COLOR.
+func (m ColorMode) Enabled(w io.Writer) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	default:
+		return os.Getenv("NO_COLOR") == "" && isTerminal(w)
+	}
+}
+
+// isTerminal reports whether w is a file descriptor attached to a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// Color is the ANSI color of a styled cell.
+type Color string
+
+const (
+	ColorNone   Color = ""
+	ColorGreen  Color = "32"
+	ColorYellow Color = "33"
+	ColorRed    Color = "31"
+)
+
+// Styled is a cell value decorated with an icon and a color.
+// Formatters return it so that the renderer decides whether to colorize it,
+// depending on its color mode and writer.
+type Styled struct {
+	Text  string
+	Icon  string
+	Color Color
+}
+
+// String returns the plain text of the cell, which is also what rows are sorted by.
+func (s Styled) String() string {
+	return s.Text
+}
+
+// Render returns the cell as printed, prefixed by its icon and wrapped in its color if colorize is set.
+func (s Styled) Render(colorize bool) string {
+	text := s.Text
+	if s.Icon != "" {
+		text = s.Icon + " " + text
+	}
+
+	if !colorize || s.Color == ColorNone {
+		return text
+	}
+
+	return "\x1b[" + string(s.Color) + "m" + text + "\x1b[0m"
+}
+
+// StatusFormatter creates a ColumnFormatter that decorates status values with an icon and a color:
+// True and OK in green, Unknown and WARNING in yellow, False and ERROR in red (case-insensitive).
+// Other values are left unchanged.
+func StatusFormatter() ColumnFormatter {
+	return func(value any) any {
+		text := fmt.Sprint(value)
+
+		switch strings.ToLower(text) {
+		case "true", "ok":
+			return Styled{Text: text, Icon: "✅", Color: ColorGreen}
+		case "unknown", "warning":
+			return Styled{Text: text, Icon: "⚠️", Color: ColorYellow}
+		case "false", "error":
+			return Styled{Text: text, Icon: "❌", Color: ColorRed}
+		default:
+			return value
+		}
+	}
+}
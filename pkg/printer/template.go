@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+// templatePrintFlags are the cli-runtime flags providing the jsonpath and go-template printers.
+type templatePrintFlags interface {
+	AllowedFormats() []string
+	ToPrinter(templateFormat string) (printers.ResourcePrinter, error)
+}
+
+// registerTemplatePrinters registers the jsonpath and go-template formats of kubectl
+// (e.g. jsonpath=<template>, jsonpath-file=<path>, go-template=<template>, go-template-file=<path>).
+// The printers are created by cli-runtime with the kubectl defaults, so that missing keys are allowed.
+func registerTemplatePrinters(r *Registry) {
+	flags := []templatePrintFlags{
+		genericclioptions.NewJSONPathPrintFlags("", true),
+		genericclioptions.NewGoTemplatePrintFlags(),
+	}
+
+	for _, f := range flags {
+		for _, format := range f.AllowedFormats() {
+			r.Register(format, func(arg string, _ Config) (Printer, error) {
+				p, err := f.ToPrinter(format + "=" + arg)
+				if err != nil {
+					return nil, err
+				}
+
+				return &ResourcePrinter{printer: p}, nil
+			})
+		}
+	}
+}
+
+// ResourcePrinter adapts a cli-runtime printer, printing the object of a Value.
+type ResourcePrinter struct {
+	printer printers.ResourcePrinter
+}
+
+// Print implements Printer.
+func (p *ResourcePrinter) Print(w io.Writer, value Value) error {
+	obj, err := toUnstructured(value.Object)
+	if err != nil {
+		return err
+	}
+
+	return p.printer.PrintObj(obj, w)
+}
+
+// toUnstructured converts an object to the runtime.Object expected by the cli-runtime printers.
+func toUnstructured(object any) (*unstructured.Unstructured, error) {
+	switch o := object.(type) {
+	case *unstructured.Unstructured:
+		return o, nil
+	case unstructured.Unstructured:
+		return &o, nil
+	case map[string]any:
+		return &unstructured.Unstructured{Object: o}, nil
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	content := map[string]any{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to convert object: %w", err)
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
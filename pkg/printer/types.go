@@ -2,34 +2,47 @@ package printer
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/lburgazzoli/odh-cli/pkg/printer/table"
 )
 
-// OutputFormat specifies the output format for printing results.
+// OutputFormat specifies the output format for printing results, either as a
+// plain format name (e.g. "json") or as "<name>=<argument>" for formats taking an argument.
 type OutputFormat string
 
 const (
 	// JSON specifies JSON output format.
 	JSON OutputFormat = "json"
+	// YAML specifies YAML output format.
+	YAML OutputFormat = "yaml"
 	// Table specifies table output format.
 	Table OutputFormat = "table"
+	// Wide specifies table output format including the columns marked as wide.
+	Wide OutputFormat = "wide"
+	// JQ specifies the jq output format, followed by the expression as in "jq=.items[].kind".
+	JQ OutputFormat = "jq"
+	// Text specifies the plain text output format, only supported by commands providing TextCapability.
+	Text OutputFormat = "text"
 )
 
 func (f *OutputFormat) String() string {
 	return string(*f)
 }
 
-// Set sets the output format from a string value.
+// Set sets the output format from a string value, failing if no printer is registered for it.
+// The argument of the format is validated when the printer is created, see Flags.Validate.
 func (f *OutputFormat) Set(v string) error {
-	switch v {
-	case string(JSON), string(Table):
-		*f = OutputFormat(v)
-
-		return nil
-	default:
-		return fmt.Errorf("invalid format: %s (must be '%s' or '%s')", v, Table, JSON)
+	if err := Supports(OutputFormat(v)); err != nil {
+		return err
 	}
+
+	*f = OutputFormat(v)
+
+	return nil
 }
 
 // Type returns the type name for the flag value.
@@ -37,8 +50,115 @@ func (f *OutputFormat) Type() string {
 	return "OutputFormat"
 }
 
+// Name returns the name of the format, without its argument.
+func (f OutputFormat) Name() string {
+	name, _, _ := strings.Cut(string(f), "=")
+
+	return name
+}
+
+// Arg returns the argument of the format, which is empty for formats without one.
+func (f OutputFormat) Arg() string {
+	_, arg, _ := strings.Cut(string(f), "=")
+
+	return arg
+}
+
 // Options contains configuration for creating a printer.
 type Options struct {
 	IOStreams    genericiooptions.IOStreams
 	OutputFormat OutputFormat
 }
+
+// Value is what a command hands to a Printer. Structured formats (json, yaml) print the
+// Object, tabular formats print one row per item of Rows using the given Columns.
+type Value struct {
+	// Object is the complete result of the command.
+	Object any
+	// Rows are the items shown by tabular formats.
+	Rows []any
+	// Columns define how each row is shown by tabular formats.
+	Columns []table.Column
+	// Footer, if set, is written after the table by the table format,
+	// e.g. to print a summary or additional sections.
+	Footer func(w io.Writer) error
+	// Text, if set, writes the plain text rendition of the value printed by the text format.
+	Text func(w io.Writer) error
+	// TableOptions are additional options of the renderer used by tabular formats,
+	// e.g. to sort or filter the rows.
+	TableOptions []table.Option[any]
+}
+
+// Capability is a rendition of its values that a command provides on top of their object.
+// Output formats may require capabilities, a command only supports the formats whose
+// requirements it provides.
+type Capability uint8
+
+const (
+	// TabularCapability is provided by commands setting the Rows and Columns of their values,
+	// it is required by the tabular formats.
+	TabularCapability Capability = 1 << iota
+	// TextCapability is provided by commands setting the Text of their values,
+	// it is required by the text format.
+	TextCapability
+)
+
+// Has reports whether all the given capabilities are provided.
+func (c Capability) Has(capabilities Capability) bool {
+	return c&capabilities == capabilities
+}
+
+// Printer writes a Value in a specific output format.
+type Printer interface {
+	Print(w io.Writer, value Value) error
+}
+
+// TabularPrinter is implemented by printers showing the Rows of a Value rather than its Object.
+// Such printers can also print rows as they arrive, e.g. when watching.
+type TabularPrinter interface {
+	Printer
+	// Stream prints the rows of the given value and returns a Stream printing the rows appended to it.
+	Stream(w io.Writer, value Value) (Stream, error)
+}
+
+// Stream prints rows as they are appended to it.
+type Stream interface {
+	// Append prints a row, or buffers it until the column widths are known.
+	Append(row any) error
+	// Render prints the buffered rows, if any.
+	Render() error
+}
+
+// Config holds the printer settings coming from flags other than the output format.
+type Config struct {
+	// RawOutput prints the string results of jq expressions without quotes.
+	RawOutput bool
+	// Variables are the string variables available to jq expressions as $<name>.
+	Variables map[string]string
+	// Color controls whether table cells are colorized.
+	Color table.ColorMode
+}
+
+// Factory creates a Printer for the argument of an output format.
+type Factory func(arg string, config Config) (Printer, error)
+
+// Rows converts a typed slice to the rows of a Value.
+func Rows[T any](items []T) []any {
+	rows := make([]any, len(items))
+	for i := range items {
+		rows[i] = items[i]
+	}
+
+	return rows
+}
+
+// noArg returns a Factory for formats that do not take an argument.
+func noArg(name OutputFormat, p Printer) Factory {
+	return func(arg string, _ Config) (Printer, error) {
+		if arg != "" {
+			return nil, fmt.Errorf("output format %s does not take an argument", name)
+		}
+
+		return p, nil
+	}
+}
@@ -0,0 +1,34 @@
+package redact
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// Flags holds the command line flags controlling redaction.
+type Flags struct {
+	// Enabled turns redaction on.
+	Enabled bool
+	// Paths are jq path expressions of additional fields to mask.
+	Paths []string
+}
+
+// AddFlags binds the redaction flags to the given flag set.
+func (f *Flags) AddFlags(flags *pflag.FlagSet) {
+	f.AddEnabledFlag(flags)
+	flags.StringArrayVar(&f.Paths, "redact-path", f.Paths, "jq path expression of an additional field to mask, implies --redact (can be repeated)")
+}
+
+// AddEnabledFlag only binds --redact to the given flag set, for commands whose output holds
+// no object that the --redact-path expressions could address.
+func (f *Flags) AddEnabledFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(&f.Enabled, "redact", f.Enabled, "Mask Secret data, bearer tokens and credentials embedded in URLs in the output")
+}
+
+// Redactor returns the Redactor configured by the flags, or nil when redaction is disabled.
+func (f *Flags) Redactor() (*Redactor, error) {
+	if !f.Enabled && len(f.Paths) == 0 {
+		return nil, nil
+	}
+
+	return New(WithPaths(f.Paths...))
+}
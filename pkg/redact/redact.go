@@ -0,0 +1,241 @@
+package redact
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/itchyny/gojq"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+)
+
+// Mask replaces redacted values.
+const Mask = "REDACTED"
+
+// lastAppliedAnnotation holds the previous configuration of an object applied
+// with kubectl, which includes the data of a Secret.
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+var (
+	bearerToken    = regexp.MustCompile(`(?i)\b(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+	urlCredentials = regexp.MustCompile(`([A-Za-z][A-Za-z0-9+.\-]*://)[^/\s:@]+(?::[^/\s@]*)?@`)
+)
+
+// Redactor masks sensitive values: the data of Secrets, the fields matching the
+// configured jq paths, bearer tokens and credentials embedded in URLs.
+// A nil Redactor leaves values untouched, so that redaction can be toggled off.
+type Redactor struct {
+	paths []*gojq.Code
+}
+
+// New returns a Redactor, failing if any of the configured jq paths is invalid.
+func New(opts ...Option) (*Redactor, error) {
+	var options Options
+
+	for _, opt := range opts {
+		opt.ApplyTo(&options)
+	}
+
+	r := &Redactor{
+		paths: make([]*gojq.Code, 0, len(options.Paths)),
+	}
+
+	for _, path := range options.Paths {
+		// Only fields that are set are masked, so that redaction does not add missing fields.
+		query, err := gojq.Parse(fmt.Sprintf(
+			`. as $root | reduce (path(%s) | select(. as $p | $root | getpath($p) != null)) as $p (.; setpath($p; %q))`,
+			path,
+			Mask,
+		))
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction path %q: %w", path, err)
+		}
+
+		code, err := gojq.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction path %q: %w", path, err)
+		}
+
+		r.paths = append(r.paths, code)
+	}
+
+	return r, nil
+}
+
+// Value returns a copy of the given value with the data of Secrets, bearer tokens and
+// credentials embedded in URLs masked at any depth. The configured jq paths address the
+// fields of an object, so they are not applied: use Unstructured or Items on the objects
+// held by the value.
+// The value is converted through its JSON representation, so it can be any type that encodes
+// to a JSON object or array. Integral numbers of generic maps and slices are decoded as int64,
+// as in unstructured objects.
+func Value[T any](r *Redactor, value T) (T, error) {
+	if r == nil {
+		return value, nil
+	}
+
+	return transform(value, func(generic any) (any, error) {
+		return r.walk(generic), nil
+	})
+}
+
+// Object returns a redacted copy of the given unstructured content, masking the fields
+// matching the configured jq paths as well.
+func (r *Redactor) Object(obj map[string]any) (map[string]any, error) {
+	if r == nil {
+		return obj, nil
+	}
+
+	return transform(obj, r.redact)
+}
+
+// Unstructured returns a redacted copy of the given object.
+func (r *Redactor) Unstructured(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if r == nil {
+		return obj, nil
+	}
+
+	content, err := r.Object(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+// Items returns a redacted copy of each of the given objects.
+func (r *Redactor) Items(items []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	if r == nil {
+		return items, nil
+	}
+
+	result := make([]unstructured.Unstructured, 0, len(items))
+
+	for i := range items {
+		redacted, err := r.Unstructured(&items[i])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, *redacted)
+	}
+
+	return result, nil
+}
+
+// String masks the bearer tokens and the credentials of URLs found in the given string.
+func (r *Redactor) String(value string) string {
+	if r == nil {
+		return value
+	}
+
+	value = bearerToken.ReplaceAllString(value, "${1}"+Mask)
+	value = urlCredentials.ReplaceAllString(value, "${1}"+Mask+"@")
+
+	return value
+}
+
+// transform applies fn to the generic JSON representation of value and converts the result back.
+func transform[T any](value T, fn func(any) (any, error)) (T, error) {
+	var result T
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal value to redact: %w", err)
+	}
+
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return result, fmt.Errorf("failed to unmarshal value to redact: %w", err)
+	}
+
+	generic, err = fn(generic)
+	if err != nil {
+		return result, err
+	}
+
+	data, err = json.Marshal(generic)
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal redacted value: %w", err)
+	}
+
+	if err := utiljson.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal redacted value: %w", err)
+	}
+
+	return result, nil
+}
+
+// redact applies the configured jq paths to the top level value, then masks
+// Secrets and sensitive strings at any depth.
+func (r *Redactor) redact(value any) (any, error) {
+	for _, code := range r.paths {
+		iter := code.Run(value)
+
+		result, ok := iter.Next()
+		if !ok {
+			continue
+		}
+
+		if err, isErr := result.(error); isErr {
+			return nil, fmt.Errorf("failed to apply redaction path: %w", err)
+		}
+
+		value = result
+	}
+
+	return r.walk(value), nil
+}
+
+func (r *Redactor) walk(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if isSecret(v) {
+			maskSecret(v)
+		}
+
+		for key, item := range v {
+			v[key] = r.walk(item)
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = r.walk(item)
+		}
+
+		return v
+	case string:
+		return r.String(v)
+	default:
+		return v
+	}
+}
+
+// isSecret reports whether the given object is a core Secret.
+func isSecret(obj map[string]any) bool {
+	return obj["apiVersion"] == "v1" && obj["kind"] == "Secret"
+}
+
+// maskSecret replaces the values of a Secret, keeping its keys, and drops the
+// last applied configuration which holds a copy of them.
+func maskSecret(obj map[string]any) {
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := obj[field].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for key := range values {
+			values[key] = Mask
+		}
+	}
+
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		if annotations, ok := metadata["annotations"].(map[string]any); ok {
+			delete(annotations, lastAppliedAnnotation)
+		}
+	}
+}
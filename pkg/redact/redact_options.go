@@ -0,0 +1,21 @@
+package redact
+
+import (
+	"github.com/lburgazzoli/odh-cli/pkg/util"
+)
+
+// Options holds the configuration of a Redactor.
+type Options struct {
+	// Paths are jq path expressions (e.g. .spec.password) of additional fields to mask.
+	Paths []string
+}
+
+// Option is a functional option for configuring a Redactor.
+type Option = util.Option[Options]
+
+// WithPaths adds jq path expressions of fields to mask.
+func WithPaths(paths ...string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.Paths = append(o.Paths, paths...)
+	})
+}
@@ -0,0 +1,197 @@
+package redact_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/redact"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the redacted objects.
+const (
+	testSecretName  = "db-credentials"
+	testSecretKey   = "password"
+	testSecretValue = "c2VjcmV0"
+	testToken       = "eyJhbGciOiJSUzI1NiJ9.payload.signature"
+	testPassword    = "hunter2"
+)
+
+func newSecret() map[string]any {
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]any{
+			"name": testSecretName,
+			"annotations": map[string]any{
+				"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"c2VjcmV0"}}`,
+			},
+		},
+		"data": map[string]any{
+			testSecretKey: testSecretValue,
+		},
+		"stringData": map[string]any{
+			testSecretKey: testPassword,
+		},
+	}
+}
+
+func newComponent() map[string]any {
+	return map[string]any{
+		"apiVersion": "components.platform.opendatahub.io/v1alpha1",
+		"kind":       "Dashboard",
+		"metadata": map[string]any{
+			"name":       "default-dashboard",
+			"generation": int64(3),
+		},
+		"spec": map[string]any{
+			"auth": map[string]any{
+				"token": testToken,
+			},
+			"registry": "https://admin:" + testPassword + "@registry.example.com/odh",
+		},
+		"status": map[string]any{
+			"message": "request failed: Authorization: Bearer " + testToken,
+		},
+	}
+}
+
+func TestRedactor(t *testing.T) {
+	t.Run("should mask Secret data and keep its keys", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := r.Object(newSecret())
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(result).Should(HaveKeyWithValue("data", HaveKeyWithValue(testSecretKey, redact.Mask)))
+		g.Expect(result).Should(HaveKeyWithValue("stringData", HaveKeyWithValue(testSecretKey, redact.Mask)))
+		g.Expect(result).Should(HaveKeyWithValue("metadata", HaveKeyWithValue("annotations", BeEmpty())))
+	})
+
+	t.Run("should mask Secrets nested in lists", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := r.Object(map[string]any{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      []any{newSecret()},
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		items, _, err := unstructured.NestedSlice(result, "items")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(items).Should(HaveLen(1))
+		g.Expect(items[0]).Should(HaveKeyWithValue("data", HaveKeyWithValue(testSecretKey, redact.Mask)))
+	})
+
+	t.Run("should mask bearer tokens and URL credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := r.Object(newComponent())
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		registry, _, _ := unstructured.NestedString(result, "spec", "registry")
+		g.Expect(registry).Should(Equal("https://" + redact.Mask + "@registry.example.com/odh"))
+
+		message, _, _ := unstructured.NestedString(result, "status", "message")
+		g.Expect(message).Should(Equal("request failed: Authorization: Bearer " + redact.Mask))
+
+		// Fields not matching any rule are left as is.
+		token, _, _ := unstructured.NestedString(result, "spec", "auth", "token")
+		g.Expect(token).Should(Equal(testToken))
+	})
+
+	t.Run("should mask fields matching the configured paths", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New(redact.WithPaths(".spec.auth.token", ".spec.missing"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := r.Object(newComponent())
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		token, _, _ := unstructured.NestedString(result, "spec", "auth", "token")
+		g.Expect(token).Should(Equal(redact.Mask))
+		g.Expect(result).Should(HaveKeyWithValue("spec", Not(HaveKey("missing"))))
+	})
+
+	t.Run("should mask fields matching the configured paths in each item", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New(redact.WithPaths(".spec.auth.token"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := r.Items([]unstructured.Unstructured{{Object: newComponent()}, {Object: newComponent()}})
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result).Should(HaveLen(2))
+
+		for _, item := range result {
+			token, _, _ := unstructured.NestedString(item.Object, "spec", "auth", "token")
+			g.Expect(token).Should(Equal(redact.Mask))
+		}
+	})
+
+	t.Run("should not apply the configured paths to values", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New(redact.WithPaths(".spec.auth.token"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		result, err := redact.Value(r, newComponent())
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		token, _, _ := unstructured.NestedString(result, "spec", "auth", "token")
+		g.Expect(token).Should(Equal(testToken))
+
+		message, _, _ := unstructured.NestedString(result, "status", "message")
+		g.Expect(message).Should(Equal("request failed: Authorization: Bearer " + redact.Mask))
+	})
+
+	t.Run("should keep integers and leave the input untouched", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r, err := redact.New(redact.WithPaths(".spec.auth.token"))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		obj := &unstructured.Unstructured{Object: newComponent()}
+
+		result, err := r.Unstructured(obj)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result.GetGeneration()).Should(Equal(int64(3)))
+
+		token, _, _ := unstructured.NestedString(obj.Object, "spec", "auth", "token")
+		g.Expect(token).Should(Equal(testToken))
+	})
+
+	t.Run("should fail on invalid paths", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := redact.New(redact.WithPaths(".spec["))
+		g.Expect(err).Should(HaveOccurred())
+	})
+
+	t.Run("should leave values untouched when disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := redact.Flags{}
+
+		r, err := flags.Redactor()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(r).Should(BeNil())
+
+		result, err := redact.Value(r, newSecret())
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(result).Should(HaveKeyWithValue("data", HaveKeyWithValue(testSecretKey, testSecretValue)))
+	})
+}
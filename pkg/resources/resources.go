@@ -2,9 +2,109 @@ package resources
 
 import "k8s.io/apimachinery/pkg/runtime/schema"
 
-// Components contains the group and version for ODH/RHOAI components.
-// Individual component types (dashboards, kserves, etc.) are discovered dynamically.
-var Components = schema.GroupVersion{
-	Group:   "components.platform.opendatahub.io",
-	Version: "v1alpha1",
+// ComponentsGroup is the API group of ODH/RHOAI components.
+// Individual component types (dashboards, kserves, etc.) and the version used to
+// access them are discovered dynamically.
+const ComponentsGroup = "components.platform.opendatahub.io"
+
+// DataScienceCluster is the resource for the singleton DataScienceCluster that
+// declares which components the operator should manage.
+var DataScienceCluster = schema.GroupVersionResource{
+	Group:    "datasciencecluster.opendatahub.io",
+	Version:  "v1",
+	Resource: "datascienceclusters",
+}
+
+// DSCInitialization is the resource for the singleton DSCInitialization that
+// configures the platform-wide settings such as the applications namespace.
+var DSCInitialization = schema.GroupVersionResource{
+	Group:    "dscinitialization.opendatahub.io",
+	Version:  "v1",
+	Resource: "dscinitializations",
+}
+
+// Notebook is the resource for workbench instances created by users.
+var Notebook = schema.GroupVersionResource{
+	Group:    "kubeflow.org",
+	Version:  "v1",
+	Resource: "notebooks",
+}
+
+// InferenceService is the resource for KServe model deployments.
+var InferenceService = schema.GroupVersionResource{
+	Group:    "serving.kserve.io",
+	Version:  "v1beta1",
+	Resource: "inferenceservices",
+}
+
+// DataSciencePipelinesApplication is the resource for pipeline server instances.
+var DataSciencePipelinesApplication = schema.GroupVersionResource{
+	Group:    "datasciencepipelinesapplications.opendatahub.io",
+	Version:  "v1",
+	Resource: "datasciencepipelinesapplications",
+}
+
+// RayCluster is the resource for Ray clusters managed by KubeRay.
+var RayCluster = schema.GroupVersionResource{
+	Group:    "ray.io",
+	Version:  "v1",
+	Resource: "rayclusters",
+}
+
+// ModelRegistry is the resource for model registry instances.
+var ModelRegistry = schema.GroupVersionResource{
+	Group:    "modelregistry.opendatahub.io",
+	Version:  "v1alpha1",
+	Resource: "modelregistries",
+}
+
+// ClusterServiceVersion is the resource for OLM ClusterServiceVersions, which
+// describe an installed version of an operator.
+var ClusterServiceVersion = schema.GroupVersionResource{
+	Group:    "operators.coreos.com",
+	Version:  "v1alpha1",
+	Resource: "clusterserviceversions",
+}
+
+// Subscription is the resource for OLM Subscriptions, which keep an operator
+// installed and up to date from a catalog channel.
+var Subscription = schema.GroupVersionResource{
+	Group:    "operators.coreos.com",
+	Version:  "v1alpha1",
+	Resource: "subscriptions",
+}
+
+// CustomResourceDefinition is the resource for Kubernetes CustomResourceDefinitions.
+var CustomResourceDefinition = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// Deployment is the resource for Kubernetes Deployments.
+var Deployment = schema.GroupVersionResource{
+	Group:    "apps",
+	Version:  "v1",
+	Resource: "deployments",
+}
+
+// Pod is the resource for Kubernetes Pods.
+var Pod = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "pods",
+}
+
+// Event is the resource for core Kubernetes Events.
+var Event = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "events",
+}
+
+// Secret is the resource for core Kubernetes Secrets.
+var Secret = schema.GroupVersionResource{
+	Group:    "",
+	Version:  "v1",
+	Resource: "secrets",
 }
@@ -6,17 +6,34 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 )
 
-// Client provides access to Kubernetes dynamic and discovery clients.
+// Client provides access to Kubernetes dynamic, discovery and typed clients.
+// The typed client is only needed for APIs that are not resource based, such as
+// pod logs, and is nil in offline mode.
 type Client struct {
-	Dynamic   dynamic.Interface
-	Discovery discovery.DiscoveryInterface
+	Dynamic    dynamic.Interface
+	Discovery  discovery.DiscoveryInterface
+	Kubernetes kubernetes.Interface
 }
 
 // NewClient creates a unified client with both dynamic and discovery capabilities.
-func NewClient(configFlags *genericclioptions.ConfigFlags) (*Client, error) {
-	restConfig, err := configFlags.ToRESTConfig()
+// In offline mode the client serves the resources of a must-gather instead of a cluster.
+func NewClient(flags *Flags) (*Client, error) {
+	if err := flags.Validate(); err != nil {
+		return nil, err
+	}
+
+	if flags.ClientFactory != nil {
+		return flags.ClientFactory()
+	}
+
+	if flags.Offline() {
+		return NewOfflineClient(flags)
+	}
+
+	restConfig, err := flags.ToRESTConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create REST config: %w", err)
 	}
@@ -31,9 +48,15 @@ func NewClient(configFlags *genericclioptions.ConfigFlags) (*Client, error) {
 		return nil, fmt.Errorf("failed to create discovery client: %w", err)
 	}
 
+	kubernetesClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
 	return &Client{
-		Dynamic:   dynamicClient,
-		Discovery: discoveryClient,
+		Dynamic:    dynamicClient,
+		Discovery:  discoveryClient,
+		Kubernetes: kubernetesClient,
 	}, nil
 }
 
@@ -0,0 +1,151 @@
+// Package fake provides a client.Client backed by the client-go fakes, for tests.
+package fake
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+)
+
+const (
+	// ComponentsVersion is the version of the components API group served by WithComponent.
+	ComponentsVersion = "v1alpha1"
+	// ComponentsAPIVersion is the apiVersion of the components served by WithComponent.
+	ComponentsAPIVersion = resources.ComponentsGroup + "/" + ComponentsVersion
+)
+
+// Options holds the resources and objects served by a fake client. The dynamic client always
+// serves the resources of the resources package.
+type Options struct {
+	listKinds map[schema.GroupVersionResource]string
+	discovery []*metav1.APIResourceList
+	objects   []runtime.Object
+}
+
+// Option is a functional option for configuring a fake client.
+type Option = util.Option[Options]
+
+// WithObjects adds objects to the dynamic client.
+func WithObjects(objects ...runtime.Object) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.objects = append(o.objects, objects...)
+	})
+}
+
+// WithComponent makes both the dynamic and the discovery clients serve a resource of the
+// components API group, in ComponentsVersion.
+func WithComponent(resource string, kind string) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.listKinds[ComponentGVR(resource)] = kind + "List"
+		o.addDiscovery(ComponentsAPIVersion, metav1.APIResource{Name: resource, Kind: kind})
+	})
+}
+
+// WithDiscovery adds resources to the discovery client only, e.g. subresources or other versions.
+// Resource lists are reported in the order they are added, the first version of a group being
+// the preferred one.
+func WithDiscovery(groupVersion string, apiResources ...metav1.APIResource) Option {
+	return util.FunctionalOption[Options](func(o *Options) {
+		o.addDiscovery(groupVersion, apiResources...)
+	})
+}
+
+func (o *Options) addDiscovery(groupVersion string, apiResources ...metav1.APIResource) {
+	for _, list := range o.discovery {
+		if list.GroupVersion == groupVersion {
+			list.APIResources = append(list.APIResources, apiResources...)
+
+			return
+		}
+	}
+
+	o.discovery = append(o.discovery, &metav1.APIResourceList{
+		GroupVersion: groupVersion,
+		APIResources: apiResources,
+	})
+}
+
+// NewClient creates a client whose dynamic, discovery and typed clients are fakes, and returns
+// it together with its fake dynamic client so that tests can add reactors to it.
+func NewClient(opts ...Option) (*client.Client, *dynamicfake.FakeDynamicClient) {
+	options := newOptions(opts...)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		options.listKinds,
+		options.objects...,
+	)
+
+	return &client.Client{
+		Dynamic: dynamicClient,
+		Discovery: &fakediscovery.FakeDiscovery{
+			Fake: &clienttesting.Fake{
+				Resources: options.discovery,
+			},
+		},
+		Kubernetes: kubernetesfake.NewClientset(),
+	}, dynamicClient
+}
+
+// NewDynamicClient creates a fake dynamic client, for tests that do not need a full client.
+func NewDynamicClient(opts ...Option) *dynamicfake.FakeDynamicClient {
+	_, dynamicClient := NewClient(opts...)
+
+	return dynamicClient
+}
+
+// NewFlags returns client flags creating the given client, for the tests of commands.
+func NewFlags(c *client.Client) *client.Flags {
+	return &client.Flags{
+		ConfigFlags: genericclioptions.NewConfigFlags(false),
+		ClientFactory: func() (*client.Client, error) {
+			return c, nil
+		},
+	}
+}
+
+// ComponentGVR returns the GroupVersionResource of a resource of the components API group served
+// by WithComponent.
+func ComponentGVR(resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    resources.ComponentsGroup,
+		Version:  ComponentsVersion,
+		Resource: resource,
+	}
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		listKinds: map[schema.GroupVersionResource]string{
+			resources.DataScienceCluster:              "DataScienceClusterList",
+			resources.DSCInitialization:               "DSCInitializationList",
+			resources.Notebook:                        "NotebookList",
+			resources.InferenceService:                "InferenceServiceList",
+			resources.DataSciencePipelinesApplication: "DataSciencePipelinesApplicationList",
+			resources.RayCluster:                      "RayClusterList",
+			resources.ModelRegistry:                   "ModelRegistryList",
+			resources.ClusterServiceVersion:           "ClusterServiceVersionList",
+			resources.Subscription:                    "SubscriptionList",
+			resources.CustomResourceDefinition:        "CustomResourceDefinitionList",
+			resources.Deployment:                      "DeploymentList",
+			resources.Pod:                             "PodList",
+			resources.Event:                           "EventList",
+			resources.Secret:                          "SecretList",
+		},
+	}
+
+	for _, opt := range opts {
+		opt.ApplyTo(&options)
+	}
+
+	return options
+}
@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+)
+
+// NewObject returns an object with the given apiVersion, kind and name, in the given namespace
+// unless it is empty.
+func NewObject(apiVersion string, kind string, namespace string, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]any{
+				"name": name,
+			},
+		},
+	}
+
+	if namespace != "" {
+		obj.SetNamespace(namespace)
+	}
+
+	return obj
+}
+
+// NewCondition returns a status condition.
+func NewCondition(conditionType string, status string, message string) map[string]any {
+	return map[string]any{
+		"type":    conditionType,
+		"status":  status,
+		"message": message,
+	}
+}
+
+// SetConditions replaces the status conditions of the object.
+func SetConditions(obj *unstructured.Unstructured, conditions ...map[string]any) {
+	items := make([]any, 0, len(conditions))
+	for _, condition := range conditions {
+		items = append(items, condition)
+	}
+
+	status, _, _ := unstructured.NestedMap(obj.Object, "status")
+	if status == nil {
+		status = map[string]any{}
+	}
+
+	status["conditions"] = items
+	obj.Object["status"] = status
+}
+
+// NewComponent returns the instance of a component of the components API group, named after its
+// kind like the ones the operator creates, with the given conditions.
+func NewComponent(kind string, conditions ...map[string]any) *unstructured.Unstructured {
+	component := NewObject(ComponentsAPIVersion, kind, "", "default-"+strings.ToLower(kind))
+	SetConditions(component, conditions...)
+
+	return component
+}
+
+// NewDataScienceCluster returns a DataScienceCluster without spec or status.
+func NewDataScienceCluster(name string) *unstructured.Unstructured {
+	return NewObject(resources.DataScienceCluster.GroupVersion().String(), "DataScienceCluster", "", name)
+}
+
+// NewCSV returns a ClusterServiceVersion in the given phase.
+func NewCSV(namespace string, name string, phase string, message string) *unstructured.Unstructured {
+	csv := NewObject(resources.ClusterServiceVersion.GroupVersion().String(), "ClusterServiceVersion", namespace, name)
+	csv.Object["status"] = map[string]any{
+		"phase":   phase,
+		"message": message,
+	}
+
+	return csv
+}
+
+// NewDeployment returns a Deployment with the given status of its Available condition.
+func NewDeployment(namespace string, name string, available string, message string) *unstructured.Unstructured {
+	deployment := NewObject(resources.Deployment.GroupVersion().String(), "Deployment", namespace, name)
+	SetConditions(deployment, NewCondition("Available", available, message))
+
+	return deployment
+}
+
+// NewCRD returns a CustomResourceDefinition with the given status of its Established condition.
+func NewCRD(name string, established string) *unstructured.Unstructured {
+	crd := NewObject(resources.CustomResourceDefinition.GroupVersion().String(), "CustomResourceDefinition", "", name)
+	SetConditions(crd, NewCondition("Established", established, ""))
+
+	return crd
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// Flags holds the flags used to build a Client: the standard kubectl flags, and
+// the must-gather source that replaces the cluster in offline mode.
+type Flags struct {
+	*genericclioptions.ConfigFlags
+
+	// FromDir is a must-gather directory the client reads from instead of a cluster.
+	FromDir string
+	// FromArchive is a must-gather .tar.gz archive the client reads from instead of a cluster.
+	FromArchive string
+
+	// ClientFactory, when set, creates the client instead of connecting to a cluster or
+	// reading a must-gather, e.g. to provide a fake client in tests.
+	ClientFactory func() (*Client, error)
+}
+
+// NewFlags returns Flags wrapping the standard kubectl flags.
+func NewFlags() *Flags {
+	return &Flags{
+		ConfigFlags: genericclioptions.NewConfigFlags(true),
+	}
+}
+
+// AddFlags binds the kubectl flags and the offline mode flags to the given flag set.
+func (f *Flags) AddFlags(flags *pflag.FlagSet) {
+	f.ConfigFlags.AddFlags(flags)
+
+	flags.StringVar(&f.FromDir, "from-dir", f.FromDir, "Read resources from a must-gather directory instead of a cluster")
+	flags.StringVar(&f.FromArchive, "from-archive", f.FromArchive, "Read resources from a must-gather .tar.gz archive instead of a cluster")
+}
+
+// Offline reports whether the client reads from a must-gather instead of a cluster.
+func (f *Flags) Offline() bool {
+	return f.FromDir != "" || f.FromArchive != ""
+}
+
+// Validate checks that at most one must-gather source is set.
+func (f *Flags) Validate() error {
+	if f.FromDir != "" && f.FromArchive != "" {
+		return fmt.Errorf("--from-dir and --from-archive are mutually exclusive")
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/yaml"
+)
+
+// Top level directories of the must-gather layout.
+const (
+	clusterScopedDir = "cluster-scoped-resources"
+	namespacedDir    = "namespaces"
+	coreGroupDir     = "core"
+)
+
+// ErrReadOnly is returned by the offline client for any request that would modify resources.
+var ErrReadOnly = errors.New("the offline client is read-only")
+
+// offlineResource is a resource type found in a must-gather, with its objects.
+type offlineResource struct {
+	gvr        schema.GroupVersionResource
+	kind       string
+	namespaced bool
+	objects    []*unstructured.Unstructured
+}
+
+// offlineLoader collects the objects found in a must-gather, keyed by resource type.
+type offlineLoader struct {
+	resources map[schema.GroupVersionResource]*offlineResource
+}
+
+// NewOfflineClient creates a read-only client serving the resources of a must-gather,
+// either extracted in a directory or packed in a .tar.gz archive.
+func NewOfflineClient(flags *Flags) (*Client, error) {
+	loader := &offlineLoader{
+		resources: make(map[schema.GroupVersionResource]*offlineResource),
+	}
+
+	var err error
+
+	switch {
+	case flags.FromDir != "":
+		err = loader.loadDir(flags.FromDir)
+	case flags.FromArchive != "":
+		err = loader.loadArchive(flags.FromArchive)
+	default:
+		err = fmt.Errorf("no must-gather source configured")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Dynamic:   &offlineDynamicClient{resources: loader.resources},
+		Discovery: &offlineDiscoveryClient{resources: loader.apiResourceLists()},
+	}, nil
+}
+
+// loadDir loads all the manifests found under the given directory.
+func (l *offlineLoader) loadDir(root string) error {
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || !isManifest(filePath) {
+			return nil
+		}
+
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+
+		return l.load(filepath.ToSlash(filePath), data)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load must-gather directory %s: %w", root, err)
+	}
+
+	return nil
+}
+
+// loadArchive loads all the manifests found in the given .tar.gz archive.
+func (l *offlineLoader) loadArchive(archive string) error {
+	file, err := os.Open(archive)
+	if err != nil {
+		return fmt.Errorf("failed to open must-gather archive: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("failed to read must-gather archive %s: %w", archive, err)
+	}
+
+	defer func() { _ = gz.Close() }()
+
+	reader := tar.NewReader(gz)
+
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read must-gather archive %s: %w", archive, err)
+		}
+
+		if header.Typeflag != tar.TypeReg || !isManifest(header.Name) {
+			continue
+		}
+
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from must-gather archive: %w", header.Name, err)
+		}
+
+		if err := l.load(header.Name, data); err != nil {
+			return fmt.Errorf("failed to load must-gather archive %s: %w", archive, err)
+		}
+	}
+}
+
+// load registers the objects of a manifest. The manifest path determines the resource
+// name and scope, following the must-gather layout:
+//
+//	cluster-scoped-resources/<group>/<resource>/<name>.yaml
+//	cluster-scoped-resources/<group>/<resource>.yaml
+//	namespaces/<namespace>/<group>/<resource>/<name>.yaml
+//	namespaces/<namespace>/<group>/<resource>.yaml
+//
+// where the core group is stored as "core". Manifests outside of this layout are ignored.
+func (l *offlineLoader) load(filePath string, data []byte) error {
+	resource, namespaced, ok := resourceFromPath(filePath)
+	if !ok {
+		return nil
+	}
+
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+
+	// Integers are decoded as int64 rather than float64, as they are by the API client.
+	obj := &unstructured.Unstructured{}
+	if err := utiljson.Unmarshal(data, &obj.Object); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+
+	if len(obj.Object) == 0 {
+		return nil
+	}
+
+	objects := []unstructured.Unstructured{*obj}
+
+	if obj.IsList() {
+		list, err := obj.ToList()
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", filePath, err)
+		}
+
+		objects = list.Items
+	}
+
+	for i := range objects {
+		l.add(&objects[i], resource, namespaced)
+	}
+
+	return nil
+}
+
+// add registers an object. The resource found in the path is only used when it
+// belongs to the same group as the object, the resource is guessed from the kind
+// otherwise (e.g. for namespaces/<namespace>/pods/<pod>/<pod>.yaml).
+// The same object is usually captured both in a list and in its own file: only the
+// first one found is kept.
+func (l *offlineLoader) add(obj *unstructured.Unstructured, resource schema.GroupResource, namespaced bool) {
+	gvk := obj.GroupVersionKind()
+	if gvk.Kind == "" || gvk.Version == "" {
+		return
+	}
+
+	gvr := gvk.GroupVersion().WithResource(resource.Resource)
+	if resource.Group != gvk.Group {
+		gvr, _ = meta.UnsafeGuessKindToResource(gvk)
+	}
+
+	res, ok := l.resources[gvr]
+	if !ok {
+		res = &offlineResource{
+			gvr:        gvr,
+			kind:       gvk.Kind,
+			namespaced: namespaced,
+		}
+		l.resources[gvr] = res
+	}
+
+	for _, existing := range res.objects {
+		if existing.GetNamespace() == obj.GetNamespace() && existing.GetName() == obj.GetName() {
+			return
+		}
+	}
+
+	res.objects = append(res.objects, obj)
+}
+
+// apiResourceLists returns the discovery information of the loaded resources. Versions of
+// a group are sorted by priority so that the newest one is reported as the preferred version.
+func (l *offlineLoader) apiResourceLists() []*metav1.APIResourceList {
+	byGroupVersion := make(map[schema.GroupVersion][]metav1.APIResource)
+
+	for gvr, res := range l.resources {
+		groupVersion := gvr.GroupVersion()
+
+		byGroupVersion[groupVersion] = append(byGroupVersion[groupVersion], metav1.APIResource{
+			Name:       gvr.Resource,
+			Kind:       res.kind,
+			Namespaced: res.namespaced,
+			Verbs:      metav1.Verbs{"get", "list"},
+		})
+	}
+
+	groupVersions := make([]schema.GroupVersion, 0, len(byGroupVersion))
+	for groupVersion := range byGroupVersion {
+		groupVersions = append(groupVersions, groupVersion)
+	}
+
+	sort.Slice(groupVersions, func(i int, j int) bool {
+		if groupVersions[i].Group != groupVersions[j].Group {
+			return groupVersions[i].Group < groupVersions[j].Group
+		}
+
+		return version.CompareKubeAwareVersionStrings(groupVersions[i].Version, groupVersions[j].Version) > 0
+	})
+
+	result := make([]*metav1.APIResourceList, 0, len(groupVersions))
+
+	for _, groupVersion := range groupVersions {
+		apiResources := byGroupVersion[groupVersion]
+
+		sort.Slice(apiResources, func(i int, j int) bool {
+			return apiResources[i].Name < apiResources[j].Name
+		})
+
+		result = append(result, &metav1.APIResourceList{
+			GroupVersion: groupVersion.String(),
+			APIResources: apiResources,
+		})
+	}
+
+	return result
+}
+
+// resourceFromPath extracts the resource and scope of the objects stored at the given
+// must-gather path.
+func resourceFromPath(filePath string) (schema.GroupResource, bool, bool) {
+	segments := strings.Split(path.Clean(filePath), "/")
+
+	for i, segment := range segments {
+		var rest []string
+		var namespaced bool
+
+		switch segment {
+		case clusterScopedDir:
+			rest = segments[i+1:]
+		case namespacedDir:
+			// Skip the namespace name
+			if len(segments) > i+2 {
+				rest = segments[i+2:]
+				namespaced = true
+			}
+		default:
+			continue
+		}
+
+		var group string
+		var resource string
+
+		switch len(rest) {
+		case 2:
+			group, resource = rest[0], strings.TrimSuffix(rest[1], path.Ext(rest[1]))
+		case 3:
+			group, resource = rest[0], rest[1]
+		default:
+			return schema.GroupResource{}, false, false
+		}
+
+		if group == coreGroupDir {
+			group = ""
+		}
+
+		return schema.GroupResource{Group: group, Resource: resource}, namespaced, true
+	}
+
+	return schema.GroupResource{}, false, false
+}
+
+// isManifest reports whether the file at the given path is a YAML manifest.
+func isManifest(filePath string) bool {
+	switch path.Ext(filePath) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	openapi_v2 "github.com/google/gnostic-models/openapiv2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/openapi"
+	restclient "k8s.io/client-go/rest"
+)
+
+// errOfflineDiscovery is returned for the discovery information that is not captured in a must-gather.
+var errOfflineDiscovery = errors.New("not available from a must-gather")
+
+// offlineDiscoveryClient is a discovery client serving the resource types found in a must-gather.
+type offlineDiscoveryClient struct {
+	// resources are sorted by group, then by version priority.
+	resources []*metav1.APIResourceList
+}
+
+// RESTClient implements discovery.DiscoveryInterface. There is no server to talk to.
+func (d *offlineDiscoveryClient) RESTClient() restclient.Interface {
+	return nil
+}
+
+// ServerGroups implements discovery.ServerGroupsInterface. The first version of each
+// group, i.e. the one with the highest priority, is reported as the preferred version.
+func (d *offlineDiscoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	groups := &metav1.APIGroupList{}
+
+	for _, resourceList := range d.resources {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		versionForDiscovery := metav1.GroupVersionForDiscovery{
+			GroupVersion: resourceList.GroupVersion,
+			Version:      groupVersion.Version,
+		}
+
+		last := len(groups.Groups) - 1
+		if last >= 0 && groups.Groups[last].Name == groupVersion.Group {
+			groups.Groups[last].Versions = append(groups.Groups[last].Versions, versionForDiscovery)
+
+			continue
+		}
+
+		groups.Groups = append(groups.Groups, metav1.APIGroup{
+			Name:             groupVersion.Group,
+			Versions:         []metav1.GroupVersionForDiscovery{versionForDiscovery},
+			PreferredVersion: versionForDiscovery,
+		})
+	}
+
+	return groups, nil
+}
+
+// ServerResourcesForGroupVersion implements discovery.ServerResourcesInterface.
+func (d *offlineDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	for _, resourceList := range d.resources {
+		if resourceList.GroupVersion == groupVersion {
+			return resourceList.DeepCopy(), nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(schema.GroupResource{}, groupVersion)
+}
+
+// ServerGroupsAndResources implements discovery.ServerResourcesInterface.
+func (d *offlineDiscoveryClient) ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error) {
+	groupList, err := d.ServerGroups()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := make([]*metav1.APIGroup, 0, len(groupList.Groups))
+	for i := range groupList.Groups {
+		groups = append(groups, &groupList.Groups[i])
+	}
+
+	resources := make([]*metav1.APIResourceList, 0, len(d.resources))
+	for _, resourceList := range d.resources {
+		resources = append(resources, resourceList.DeepCopy())
+	}
+
+	return groups, resources, nil
+}
+
+// ServerPreferredResources implements discovery.ServerResourcesInterface.
+func (d *offlineDiscoveryClient) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return discovery.ServerPreferredResources(d)
+}
+
+// ServerPreferredNamespacedResources implements discovery.ServerResourcesInterface.
+func (d *offlineDiscoveryClient) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	return discovery.ServerPreferredNamespacedResources(d)
+}
+
+// ServerVersion implements discovery.ServerVersionInterface.
+func (d *offlineDiscoveryClient) ServerVersion() (*version.Info, error) {
+	return nil, fmt.Errorf("server version: %w", errOfflineDiscovery)
+}
+
+// OpenAPISchema implements discovery.OpenAPISchemaInterface.
+func (d *offlineDiscoveryClient) OpenAPISchema() (*openapi_v2.Document, error) {
+	return nil, fmt.Errorf("OpenAPI schema: %w", errOfflineDiscovery)
+}
+
+// OpenAPIV3 implements discovery.OpenAPIV3SchemaInterface. There is no server to talk to.
+func (d *offlineDiscoveryClient) OpenAPIV3() openapi.Client {
+	return nil
+}
+
+// WithLegacy implements discovery.DiscoveryInterface.
+func (d *offlineDiscoveryClient) WithLegacy() discovery.DiscoveryInterface {
+	return d
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+)
+
+// offlineDynamicClient is a read-only dynamic client serving the objects of a must-gather.
+type offlineDynamicClient struct {
+	resources map[schema.GroupVersionResource]*offlineResource
+}
+
+// Resource implements dynamic.Interface. Resources missing from the must-gather are
+// reported as not found, like resources that are not served by a cluster.
+func (c *offlineDynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &offlineResourceClient{
+		gvr:      gvr,
+		resource: c.resources[gvr],
+	}
+}
+
+// offlineResourceClient serves the objects of a resource type, optionally restricted to a namespace.
+type offlineResourceClient struct {
+	gvr       schema.GroupVersionResource
+	resource  *offlineResource
+	namespace string
+}
+
+// Namespace implements dynamic.NamespaceableResourceInterface.
+func (c *offlineResourceClient) Namespace(namespace string) dynamic.ResourceInterface {
+	return &offlineResourceClient{
+		gvr:       c.gvr,
+		resource:  c.resource,
+		namespace: namespace,
+	}
+}
+
+// Get implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Get(
+	_ context.Context,
+	name string,
+	_ metav1.GetOptions,
+	subresources ...string,
+) (*unstructured.Unstructured, error) {
+	if c.resource != nil && len(subresources) == 0 {
+		for _, obj := range c.resource.objects {
+			if obj.GetName() == name && c.inNamespace(obj) {
+				return obj.DeepCopy(), nil
+			}
+		}
+	}
+
+	return nil, apierrors.NewNotFound(c.gvr.GroupResource(), name)
+}
+
+// List implements dynamic.ResourceInterface. Label selectors are supported, as well as
+// field selectors on any field of the objects (e.g. involvedObject.uid for events).
+func (c *offlineResourceClient) List(_ context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if c.resource == nil {
+		return nil, apierrors.NewNotFound(c.gvr.GroupResource(), "")
+	}
+
+	labelSelector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err))
+	}
+
+	fieldSelector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid field selector: %v", err))
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(c.gvr.GroupVersion().String())
+	list.SetKind(c.resource.kind + "List")
+
+	for _, obj := range c.resource.objects {
+		if !c.inNamespace(obj) {
+			continue
+		}
+
+		if !labelSelector.Matches(labels.Set(obj.GetLabels())) || !fieldSelector.Matches(objectFields{obj: obj}) {
+			continue
+		}
+
+		list.Items = append(list.Items, *obj.DeepCopy())
+	}
+
+	return list, nil
+}
+
+// Watch implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Watch(context.Context, metav1.ListOptions) (watch.Interface, error) {
+	return nil, fmt.Errorf("watch %s: not supported by the offline client", c.gvr.Resource)
+}
+
+// Create implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Create(
+	context.Context,
+	*unstructured.Unstructured,
+	metav1.CreateOptions,
+	...string,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("create")
+}
+
+// Update implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Update(
+	context.Context,
+	*unstructured.Unstructured,
+	metav1.UpdateOptions,
+	...string,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("update")
+}
+
+// UpdateStatus implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) UpdateStatus(
+	context.Context,
+	*unstructured.Unstructured,
+	metav1.UpdateOptions,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("update")
+}
+
+// Delete implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Delete(context.Context, string, metav1.DeleteOptions, ...string) error {
+	return c.readOnly("delete")
+}
+
+// DeleteCollection implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) DeleteCollection(context.Context, metav1.DeleteOptions, metav1.ListOptions) error {
+	return c.readOnly("delete-collection")
+}
+
+// Patch implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Patch(
+	context.Context,
+	string,
+	types.PatchType,
+	[]byte,
+	metav1.PatchOptions,
+	...string,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("patch")
+}
+
+// Apply implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) Apply(
+	context.Context,
+	string,
+	*unstructured.Unstructured,
+	metav1.ApplyOptions,
+	...string,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("patch")
+}
+
+// ApplyStatus implements dynamic.ResourceInterface.
+func (c *offlineResourceClient) ApplyStatus(
+	context.Context,
+	string,
+	*unstructured.Unstructured,
+	metav1.ApplyOptions,
+) (*unstructured.Unstructured, error) {
+	return nil, c.readOnly("patch")
+}
+
+// inNamespace reports whether the object belongs to the namespace of the client, if any.
+func (c *offlineResourceClient) inNamespace(obj *unstructured.Unstructured) bool {
+	return c.namespace == "" || obj.GetNamespace() == c.namespace
+}
+
+func (c *offlineResourceClient) readOnly(verb string) error {
+	return fmt.Errorf("%s %s: %w", verb, c.gvr.Resource, ErrReadOnly)
+}
+
+// objectFields exposes the fields of an object to field selectors, by their dotted path.
+type objectFields struct {
+	obj *unstructured.Unstructured
+}
+
+// Has implements fields.Fields.
+func (f objectFields) Has(field string) bool {
+	_, found, _ := unstructured.NestedFieldNoCopy(f.obj.Object, strings.Split(field, ".")...)
+
+	return found
+}
+
+// Get implements fields.Fields.
+func (f objectFields) Get(field string) string {
+	value, found, _ := unstructured.NestedFieldNoCopy(f.obj.Object, strings.Split(field, ".")...)
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,303 @@
+package client_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/lburgazzoli/odh-cli/pkg/components"
+	"github.com/lburgazzoli/odh-cli/pkg/resources"
+	"github.com/lburgazzoli/odh-cli/pkg/util/client"
+
+	. "github.com/onsi/gomega"
+)
+
+// Test constants for the must-gather layout.
+const (
+	testMustGatherPrefix = "must-gather.local.123/quay-io-opendatahub-must-gather"
+	testKserveV1Path     = "cluster-scoped-resources/components.platform.opendatahub.io/kserves/default-kserve.yaml"
+	testKserveOldPath    = "cluster-scoped-resources/components.platform.opendatahub.io/kserves.yaml"
+	testDeploymentsPath  = "namespaces/opendatahub/apps/deployments.yaml"
+	testDeploymentPath   = "namespaces/opendatahub/apps/deployments/odh-dashboard.yaml"
+	testPodPath          = "namespaces/opendatahub/pods/odh-dashboard-abc/odh-dashboard-abc.yaml"
+	testLogPath          = "namespaces/opendatahub/pods/odh-dashboard-abc/odh-dashboard/logs/current.log"
+	testNamespace        = "opendatahub"
+	testDeploymentName   = "odh-dashboard"
+	testPodName          = "odh-dashboard-abc"
+	testKserveName       = "default-kserve"
+	testLogContent       = "not a manifest"
+)
+
+const testKserveV1Manifest = `
+apiVersion: components.platform.opendatahub.io/v1
+kind: Kserve
+metadata:
+  name: default-kserve
+  generation: 2
+status:
+  observedGeneration: 2
+  conditions:
+  - type: Ready
+    status: "True"
+`
+
+const testKserveOldManifest = `
+apiVersion: components.platform.opendatahub.io/v1alpha1
+kind: KserveList
+items:
+- apiVersion: components.platform.opendatahub.io/v1alpha1
+  kind: Kserve
+  metadata:
+    name: default-kserve
+`
+
+const testDeploymentsManifest = `
+apiVersion: apps/v1
+kind: DeploymentList
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: odh-dashboard
+    namespace: opendatahub
+`
+
+const testDeploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: odh-dashboard
+  namespace: opendatahub
+`
+
+const testPodManifest = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: odh-dashboard-abc
+  namespace: opendatahub
+  labels:
+    app: odh-dashboard
+`
+
+func mustGatherFiles() map[string]string {
+	return map[string]string{
+		testKserveV1Path:    testKserveV1Manifest,
+		testKserveOldPath:   testKserveOldManifest,
+		testDeploymentsPath: testDeploymentsManifest,
+		testDeploymentPath:  testDeploymentManifest,
+		testPodPath:         testPodManifest,
+		testLogPath:         testLogContent,
+	}
+}
+
+func writeMustGatherDir(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	for name, content := range mustGatherFiles() {
+		filePath := filepath.Join(root, testMustGatherPrefix, name)
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	return root
+}
+
+func writeMustGatherArchive(t *testing.T) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range mustGatherFiles() {
+		header := &tar.Header{
+			Name:     testMustGatherPrefix + "/" + name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	archive := filepath.Join(t.TempDir(), "must-gather.tar.gz")
+	if err := os.WriteFile(archive, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return archive
+}
+
+func TestOfflineClient(t *testing.T) {
+	sources := map[string]func(t *testing.T) *client.Flags{
+		"directory": func(t *testing.T) *client.Flags {
+			flags := client.NewFlags()
+			flags.FromDir = writeMustGatherDir(t)
+
+			return flags
+		},
+		"archive": func(t *testing.T) *client.Flags {
+			flags := client.NewFlags()
+			flags.FromArchive = writeMustGatherArchive(t)
+
+			return flags
+		},
+	}
+
+	for source, newFlags := range sources {
+		t.Run(source, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c, err := client.NewClient(newFlags(t))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			t.Run("should list components with the preferred version", func(t *testing.T) {
+				g := NewWithT(t)
+
+				result, err := components.ListComponents(t.Context(), c)
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(result.Errors).Should(BeEmpty())
+				g.Expect(result.Items).Should(HaveLen(1))
+				g.Expect(result.Items[0].GetName()).Should(Equal(testKserveName))
+				g.Expect(result.Items[0].GetAPIVersion()).Should(Equal(resources.ComponentsGroup + "/v1"))
+
+				// Integers are decoded as int64, as they are by the API client.
+				g.Expect(result.Items[0].GetGeneration()).Should(Equal(int64(2)))
+
+				observedGeneration, _, err := unstructured.NestedInt64(result.Items[0].Object, "status", "observedGeneration")
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(observedGeneration).Should(Equal(int64(2)))
+			})
+
+			t.Run("should serve namespaced resources captured twice once", func(t *testing.T) {
+				g := NewWithT(t)
+
+				list, err := c.Dynamic.Resource(resources.Deployment).Namespace(testNamespace).List(t.Context(), metav1.ListOptions{})
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(list.Items).Should(HaveLen(1))
+				g.Expect(list.Items[0].GetName()).Should(Equal(testDeploymentName))
+			})
+
+			t.Run("should serve pods stored in their own directory", func(t *testing.T) {
+				g := NewWithT(t)
+
+				pod, err := c.Dynamic.Resource(resources.Pod).Namespace(testNamespace).Get(t.Context(), testPodName, metav1.GetOptions{})
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(pod.GetName()).Should(Equal(testPodName))
+			})
+
+			t.Run("should discover the loaded resources", func(t *testing.T) {
+				g := NewWithT(t)
+
+				groups, err := c.Discovery.ServerGroups()
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(groups.Groups).Should(ContainElement(And(
+					HaveField("Name", resources.ComponentsGroup),
+					HaveField("PreferredVersion.Version", "v1"),
+					HaveField("Versions", HaveLen(2)),
+				)))
+
+				resourceList, err := c.Discovery.ServerResourcesForGroupVersion("apps/v1")
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(resourceList.APIResources).Should(ConsistOf(And(
+					HaveField("Name", "deployments"),
+					HaveField("Kind", "Deployment"),
+					HaveField("Namespaced", true),
+				)))
+			})
+
+			t.Run("should filter lists by label and field selectors", func(t *testing.T) {
+				g := NewWithT(t)
+
+				pods := c.Dynamic.Resource(resources.Pod).Namespace(testNamespace)
+
+				list, err := pods.List(t.Context(), metav1.ListOptions{LabelSelector: "app=" + testDeploymentName})
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(list.Items).Should(HaveLen(1))
+
+				list, err = pods.List(t.Context(), metav1.ListOptions{FieldSelector: "metadata.name=" + testPodName})
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(list.Items).Should(HaveLen(1))
+
+				list, err = pods.List(t.Context(), metav1.ListOptions{FieldSelector: "metadata.name=" + testDeploymentName})
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(list.Items).Should(BeEmpty())
+			})
+
+			t.Run("should report resources missing from the must-gather as not found", func(t *testing.T) {
+				g := NewWithT(t)
+
+				gvr := schema.GroupVersionResource{Group: "operators.coreos.com", Version: "v1alpha1", Resource: "clusterserviceversions"}
+
+				_, err := c.Dynamic.Resource(gvr).List(t.Context(), metav1.ListOptions{})
+				g.Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+
+				_, err = c.Dynamic.Resource(gvr).Namespace(testNamespace).List(t.Context(), metav1.ListOptions{})
+				g.Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+			})
+
+			t.Run("should reject mutations", func(t *testing.T) {
+				g := NewWithT(t)
+
+				_, err := c.Dynamic.Resource(resources.Deployment).Namespace(testNamespace).Patch(
+					t.Context(),
+					testDeploymentName,
+					types.MergePatchType,
+					[]byte(`{}`),
+					metav1.PatchOptions{},
+				)
+				g.Expect(err).Should(MatchError(client.ErrReadOnly))
+
+				err = c.Dynamic.Resource(resources.Deployment).Namespace(testNamespace).Delete(t.Context(), testDeploymentName, metav1.DeleteOptions{})
+				g.Expect(err).Should(MatchError(client.ErrReadOnly))
+
+				_, err = c.Dynamic.Resource(resources.Deployment).Namespace(testNamespace).Create(t.Context(), &unstructured.Unstructured{}, metav1.CreateOptions{})
+				g.Expect(err).Should(MatchError(client.ErrReadOnly))
+			})
+		})
+	}
+
+	t.Run("should reject both sources at once", func(t *testing.T) {
+		g := NewWithT(t)
+
+		flags := client.NewFlags()
+		flags.FromDir = testNamespace
+		flags.FromArchive = testNamespace
+
+		_, err := client.NewClient(flags)
+		g.Expect(err).Should(MatchError(ContainSubstring("mutually exclusive")))
+	})
+}
@@ -0,0 +1,87 @@
+package conditions
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TypeReady is the condition type reported by ODH/RHOAI resources once they are fully reconciled.
+const TypeReady = "Ready"
+
+// List returns the conditions found in status.conditions of the given object.
+// Malformed entries are skipped, a missing conditions list results in an empty slice.
+func List(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	items, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status.conditions: %w", err)
+	}
+
+	if !found {
+		return []metav1.Condition{}, nil
+	}
+
+	result := make([]metav1.Condition, 0, len(items))
+
+	for _, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		condition := metav1.Condition{
+			Type:    stringField(fields, "type"),
+			Status:  metav1.ConditionStatus(stringField(fields, "status")),
+			Reason:  stringField(fields, "reason"),
+			Message: stringField(fields, "message"),
+		}
+
+		if ts, err := time.Parse(time.RFC3339, stringField(fields, "lastTransitionTime")); err == nil {
+			condition.LastTransitionTime = metav1.NewTime(ts)
+		}
+
+		if generation, ok := fields["observedGeneration"].(int64); ok {
+			condition.ObservedGeneration = generation
+		}
+
+		result = append(result, condition)
+	}
+
+	return result, nil
+}
+
+// Find returns the condition with the given type, or nil if the object does not report it.
+func Find(obj *unstructured.Unstructured, conditionType string) (*metav1.Condition, error) {
+	items, err := List(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Type == conditionType {
+			return &items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// IsTrue reports whether the condition with the given type exists and has status True.
+func IsTrue(obj *unstructured.Unstructured, conditionType string) bool {
+	condition, err := Find(obj, conditionType)
+	if err != nil || condition == nil {
+		return false
+	}
+
+	return condition.Status == metav1.ConditionTrue
+}
+
+func stringField(fields map[string]any, name string) string {
+	if v, ok := fields[name].(string); ok {
+		return v
+	}
+
+	return ""
+}
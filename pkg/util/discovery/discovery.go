@@ -32,29 +32,108 @@ func GetGroupResources(
 	return resources, nil
 }
 
-// GetGroupVersionResources returns all API resources for a specific group and version.
-func GetGroupVersionResources(
+// GetPreferredGroupResources returns the API resources of a group, each one resolved to the
+// version preferred by the server. Resources only served by non-preferred versions are
+// included with the first version (in server order) that serves them.
+// Non-preferred versions whose discovery fails (e.g. an unavailable aggregated API or
+// conversion webhook) are skipped, so that they do not hide the resources of the preferred one.
+// If version is not empty, only resources of that version are returned and an error is
+// returned when the server does not serve it.
+// The Group and Version fields of the returned resources are always set.
+func GetPreferredGroupResources(
 	discoveryClient discovery.DiscoveryInterface,
-	groupVersion schema.GroupVersion,
+	groupName string,
+	version string,
 ) ([]metav1.APIResource, error) {
-	// Use ServerGroupsAndResources which is more robust for CRDs
-	// Note: This can return partial errors but still provide results
-	_, apiResourceLists, err := discoveryClient.ServerGroupsAndResources()
+	groups, err := discoveryClient.ServerGroups()
 	if err != nil {
-		// Don't return error here - partial errors are common and we can still use the results
+		return nil, fmt.Errorf("failed to discover server groups: %w", err)
 	}
 
-	if apiResourceLists == nil {
+	var group *metav1.APIGroup
+
+	for i := range groups.Groups {
+		if groups.Groups[i].Name == groupName {
+			group = &groups.Groups[i]
+
+			break
+		}
+	}
+
+	if group == nil {
+		if version != "" {
+			return nil, fmt.Errorf("API group %s is not served", groupName)
+		}
+
+		// Empty list is valid - means the group is not installed
 		return []metav1.APIResource{}, nil
 	}
 
-	// Find the matching group/version
-	for _, apiResourceList := range apiResourceLists {
-		if apiResourceList.GroupVersion == groupVersion.String() {
-			return apiResourceList.APIResources, nil
+	versions := preferredVersionOrder(group)
+
+	if version != "" {
+		served := false
+
+		for _, v := range versions {
+			if v == version {
+				served = true
+
+				break
+			}
+		}
+
+		if !served {
+			return nil, fmt.Errorf("API version %s/%s is not served (available: %v)", groupName, version, versions)
 		}
+
+		versions = []string{version}
 	}
 
-	// Empty list is valid - means no resources of this type exist
-	return []metav1.APIResource{}, nil
+	var resources []metav1.APIResource
+
+	seen := make(map[string]bool)
+
+	for i, v := range versions {
+		gv := schema.GroupVersion{Group: groupName, Version: v}
+
+		resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+		if err != nil {
+			if i > 0 {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to discover resources for %s: %w", gv, err)
+		}
+
+		for _, resource := range resourceList.APIResources {
+			if seen[resource.Name] {
+				continue
+			}
+
+			seen[resource.Name] = true
+
+			resource.Group = groupName
+			resource.Version = v
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources, nil
+}
+
+// preferredVersionOrder returns the versions of the group with the preferred version first.
+func preferredVersionOrder(group *metav1.APIGroup) []string {
+	versions := make([]string, 0, len(group.Versions))
+
+	if group.PreferredVersion.Version != "" {
+		versions = append(versions, group.PreferredVersion.Version)
+	}
+
+	for _, v := range group.Versions {
+		if v.Version != group.PreferredVersion.Version {
+			versions = append(versions, v.Version)
+		}
+	}
+
+	return versions
 }
@@ -0,0 +1,54 @@
+package dryrun
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Strategy specifies how a mutating command handles the --dry-run flag.
+type Strategy string
+
+const (
+	// None sends the request to the server and persists the change.
+	None Strategy = "none"
+	// Client only prints the change without contacting the server.
+	Client Strategy = "client"
+	// Server submits the change as a server-side dry-run request without persisting it.
+	Server Strategy = "server"
+)
+
+func (s *Strategy) String() string {
+	return string(*s)
+}
+
+// Set sets the dry-run strategy from a string value.
+func (s *Strategy) Set(v string) error {
+	switch v {
+	case string(None), string(Client), string(Server):
+		*s = Strategy(v)
+
+		return nil
+	default:
+		return fmt.Errorf("invalid dry-run value: %s (must be '%s', '%s' or '%s')", v, None, Client, Server)
+	}
+}
+
+// Type returns the type name for the flag value.
+func (s *Strategy) Type() string {
+	return "string"
+}
+
+// Enabled reports whether any dry-run strategy is active.
+func (s Strategy) Enabled() bool {
+	return s == Client || s == Server
+}
+
+// Options returns the value for the DryRun field of metav1 request options.
+func (s Strategy) Options() []string {
+	if s == Server {
+		return []string{metav1.DryRunAll}
+	}
+
+	return nil
+}
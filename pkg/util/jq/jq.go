@@ -2,8 +2,12 @@ package jq
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/itchyny/gojq"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -31,8 +35,9 @@ func convertValue(value any) (any, error) {
 	rv := reflect.ValueOf(value)
 	kind := rv.Kind()
 
-	// Handle maps - pass through directly
-	if kind == reflect.Map {
+	// Handle generic maps - pass through directly, other map types
+	// (e.g. map[string]string) are normalized below
+	if _, ok := value.(map[string]any); ok {
 		return value, nil
 	}
 
@@ -96,3 +101,113 @@ func Query(value any, jqQuery string) (any, error) {
 
 	return result, nil
 }
+
+// Program is a compiled jq expression which can be run against multiple values.
+type Program struct {
+	code   *gojq.Code
+	values []any
+}
+
+// Compile compiles a jq expression. The given variables are available to the
+// expression as $<name>, and the environment variables as $ENV.
+func Compile(jqQuery string, variables map[string]string) (*Program, error) {
+	query, err := gojq.Parse(jqQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse jq query: %w", err)
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	values := make([]any, 0, len(names))
+	for i, name := range names {
+		values = append(values, variables[name])
+		names[i] = "$" + name
+	}
+
+	code, err := gojq.Compile(
+		query,
+		gojq.WithVariables(names),
+		gojq.WithEnvironLoader(os.Environ),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile jq query: %w", err)
+	}
+
+	return &Program{
+		code:   code,
+		values: values,
+	}, nil
+}
+
+// Run executes the expression against the provided value and calls fn with every result,
+// stopping at the first error. The value is converted to a JQ-compatible format before processing.
+func (p *Program) Run(value any, fn func(result any) error) error {
+	normalizedValue, err := convertValue(value)
+	if err != nil {
+		return err
+	}
+
+	iter := p.code.Run(normalizedValue, p.values...)
+
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+
+		if err, isErr := result.(error); isErr {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				return nil
+			}
+
+			return fmt.Errorf("jq query error: %w", err)
+		}
+
+		if err := fn(result); err != nil {
+			return err
+		}
+	}
+}
+
+// Split splits a list of jq expressions on sep, ignoring separators nested in
+// brackets, parentheses, braces or string literals of the expressions.
+func Split(s string, sep rune) []string {
+	var (
+		parts   []string
+		current strings.Builder
+		depth   int
+		quoted  bool
+		escaped bool
+	)
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case (r == ')' || r == ']' || r == '}') && depth > 0:
+			depth--
+		case r == sep && depth == 0:
+			parts = append(parts, current.String())
+			current.Reset()
+
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	return append(parts, current.String())
+}